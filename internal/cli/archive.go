@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/store"
+)
+
+// defaultRetentionDays is how long transcripts stay hot without
+// --older-than.
+const defaultRetentionDays = 90
+
+// archiveBundle is one monthly bundle senate archive wrote to.
+type archiveBundle struct {
+	Month       string   `json:"month"`
+	Transcripts int      `json:"transcripts"`
+	CaseIDs     []string `json:"case_ids"`
+	Location    string   `json:"location"`
+}
+
+// archiveReport is the result of senate archive.
+type archiveReport struct {
+	OlderThanDays int             `json:"older_than_days"`
+	Cutoff        string          `json:"cutoff"`
+	DryRun        bool            `json:"dry_run"`
+	Hot           int             `json:"hot"`
+	Archived      int             `json:"archived"`
+	Bundles       []archiveBundle `json:"bundles"`
+}
+
+// cmdArchive applies the retention policy: transcripts completed more than
+// --older-than days ago move into compressed monthly bundles. Verdicts,
+// precedent and everything else stay hot, and archived transcripts remain
+// readable by every command.
+func cmdArchive(args []string) int {
+	flags := parseFlags(args)
+	days, err := parseDays(flags["older-than"], defaultRetentionDays)
+	if err != nil {
+		errorf("%v", err)
+		return 1
+	}
+	d, err := openStore(flags)
+	if err != nil {
+		errorf("open store: %v", err)
+		return 1
+	}
+	defer d.Close()
+
+	now := time.Now().UTC()
+	cutoff := now.AddDate(0, 0, -days)
+	r := archiveReport{OlderThanDays: days, Cutoff: cutoff.Format(time.RFC3339), DryRun: flagBool(args, "--dry-run"), Bundles: []archiveBundle{}}
+	run := d.Update
+	if r.DryRun {
+		run = d.View
+	}
+	err = run(func(tx store.Store) error {
+		ids, err := tx.TranscriptIDs()
+		if err != nil {
+			return err
+		}
+		archived, err := tx.ArchivedTranscriptIDs()
+		if err != nil {
+			return err
+		}
+		cold := map[string]bool{}
+		for _, id := range archived {
+			cold[id] = true
+		}
+		byMonth := map[string][]string{}
+		for _, id := range ids {
+			if cold[id] {
+				continue
+			}
+			r.Hot++
+			t, err := tx.LoadTranscript(id)
+			if err != nil {
+				return err
+			}
+			at, ok := transcriptTime(t)
+			if !ok || at.After(cutoff) {
+				continue
+			}
+			month := at.Format("2006-01")
+			byMonth[month] = append(byMonth[month], id)
+		}
+		months := make([]string, 0, len(byMonth))
+		for month := range byMonth {
+			months = append(months, month)
+		}
+		sort.Strings(months)
+		for _, month := range months {
+			ids := byMonth[month]
+			if !r.DryRun {
+				if err := tx.ArchiveTranscripts(month, ids); err != nil {
+					return fmt.Errorf("archive %s: %w", month, err)
+				}
+			}
+			r.Archived += len(ids)
+			r.Bundles = append(r.Bundles, archiveBundle{Month: month, Transcripts: len(ids), CaseIDs: ids, Location: tx.Location(store.KindArchive, month)})
+		}
+		return nil
+	})
+	if err != nil {
+		errorf("archive: %v", err)
+		return 1
+	}
+
+	if flagBool(args, "--json") {
+		outputJSON(r)
+		return 0
+	}
+	verb := "archived"
+	if r.DryRun {
+		verb = "dry run: would archive"
+	}
+	fmt.Printf("%s %d of %d hot transcripts completed by %s\n", verb, r.Archived, r.Hot, r.Cutoff)
+	for _, b := range r.Bundles {
+		fmt.Printf("%s: %d -> %s\n", b.Month, b.Transcripts, b.Location)
+	}
+	return 0
+}
+
+// transcriptTime is when a transcript's deliberation completed, or started
+// when it has no completion time.
+func transcriptTime(t core.Transcript) (time.Time, bool) {
+	for _, raw := range []string{t.CompletedAt, t.StartedAt} {
+		if at, err := time.Parse(time.RFC3339, raw); err == nil {
+			return at.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseDays reads a day count such as 90 or 90d.
+func parseDays(raw string, fallback int) (int, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(raw, "d"))
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("--older-than must be a number of days (e.g. 90 or 90d), got %q", raw)
+	}
+	return n, nil
+}
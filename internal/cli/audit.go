@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Perttulands/senate/internal/ledger"
+	"github.com/Perttulands/senate/internal/store"
+)
+
+// Ledger reasons: why a document was (re)recorded.
+const (
+	reasonIssued      = "issued"
+	reasonRatified    = "ratified"
+	reasonHandoff     = "handoff"
+	reasonDeliberated = "deliberated"
+	reasonAdopted     = ledger.ReasonAdopted
+)
+
+// recordLedger chains an entry for doc onto the audit ledger. Callers run it
+// inside Update so the last entry cannot change between reading and
+// appending.
+func recordLedger(tx store.LedgerStore, kind, caseID string, doc any, reason string, now time.Time) error {
+	entries, err := tx.LoadLedger()
+	if err != nil {
+		return err
+	}
+	var prev *ledger.Entry
+	if len(entries) > 0 {
+		prev = &entries[len(entries)-1]
+	}
+	e, err := ledger.Next(prev, kind, caseID, doc, reason, now)
+	if err != nil {
+		return err
+	}
+	return tx.AppendLedger(e)
+}
+
+func cmdAudit(args []string) int {
+	if len(args) == 0 || args[0] != "verify" {
+		errorf("usage: senate audit verify [--json] [--state-dir <path>]")
+		return 1
+	}
+	return cmdAuditVerify(args[1:])
+}
+
+// cmdAuditVerify recomputes the ledger chain and checks every stored
+// verdict, transcript and precedent record against it. It exits 1 when
+// anything was altered, deleted or never recorded, and lists documents the
+// ledger adopted rather than recorded at issue.
+func cmdAuditVerify(args []string) int {
+	flags := parseFlags(args)
+	d, err := openStore(flags)
+	if err != nil {
+		errorf("open store: %v", err)
+		return 1
+	}
+	defer d.Close()
+
+	var r ledger.Report
+	err = d.View(func(tx store.Store) error {
+		entries, err := tx.LoadLedger()
+		if err != nil {
+			return err
+		}
+		r, err = ledger.Verify(entries, tx)
+		return err
+	})
+	if err != nil {
+		errorf("audit: %v", err)
+		return 1
+	}
+
+	code := 0
+	if !r.OK() {
+		code = 1
+	}
+	if flagBool(args, "--json") {
+		outputJSON(r)
+		return code
+	}
+	fmt.Printf("ledger: %s (%d entries)\n", d.Location(store.KindLedger, ""), r.Entries)
+	fmt.Printf("checked: %d verdicts, %d transcripts, %d precedents\n", r.Verdicts, r.Transcripts, r.Precedents)
+	for _, e := range r.Adopted {
+		fmt.Printf("adopted %s %s (entry %d, %s): recorded by migrate, not issued through the ledger\n", e.Kind, e.CaseID, e.Seq, e.RecordedAt)
+	}
+	if r.OK() {
+		fmt.Println("ok: chain intact, every document matches its recorded hash")
+		return 0
+	}
+	for _, p := range r.Problems {
+		parts := []string{p.Issue, p.Kind}
+		if p.CaseID != "" {
+			parts = append(parts, p.CaseID)
+		}
+		if p.Seq > 0 {
+			parts = append(parts, fmt.Sprintf("(entry %d)", p.Seq))
+		}
+		fmt.Println(strings.Join(parts, " "))
+	}
+	fmt.Printf("failed: %d problems\n", len(r.Problems))
+	return code
+}
@@ -0,0 +1,231 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/deliberation"
+	"github.com/Perttulands/senate/internal/store"
+)
+
+func cmdCase(args []string) int {
+	if len(args) < 1 {
+		errorf("usage: senate case list|show|amend|transition [flags]")
+		return 1
+	}
+	switch args[0] {
+	case "amend":
+		return cmdCaseAmend(args[1:])
+	case "transition":
+		return cmdCaseTransition(args[1:])
+	case "list":
+		return cmdCaseList(args[1:])
+	case "show":
+		return cmdCaseShow(args[1:])
+	default:
+		errorf("unknown case subcommand: %s", args[0])
+		return 1
+	}
+}
+
+// fileCase stores a newly filed case, already moved on to status next. A
+// case whose ID is already taken is refused unless --supersedes names that
+// case; the new case then records the case it supersedes and, when it reuses
+// that ID, is refiled under a new one. The superseded case is marked so.
+func fileCase(d store.Store, c *core.Case, supersedes string, next core.CaseStatus, now time.Time) error {
+	if supersedes = strings.TrimSpace(supersedes); supersedes != "" {
+		if _, err := d.LoadCase(supersedes); err != nil {
+			return fmt.Errorf("superseded case: %w", err)
+		}
+		c.Supersedes = supersedes
+		if c.ID == supersedes {
+			c.ID = core.NewCaseID(now)
+		}
+	}
+	filer := c.FiledBy
+	if filer == "" {
+		filer = senateActor
+	}
+	c.Status, c.History = "", nil
+	if err := c.Advance(core.StatusFiled, now, filer, ""); err != nil {
+		return err
+	}
+	if err := c.Advance(next, now, senateActor, ""); err != nil {
+		return err
+	}
+	return d.Update(func(tx store.Store) error {
+		err := tx.SaveCase(*c)
+		if errors.Is(err, fs.ErrExist) {
+			return fmt.Errorf("case %s already exists; pass --supersedes %s to file a superseding case", c.ID, c.ID)
+		}
+		if err != nil || supersedes == "" {
+			return err
+		}
+		_, err = advanceCase(tx, supersedes, core.StatusSuperseded, filer, "superseded by "+c.ID, now)
+		return err
+	})
+}
+
+// requestEvidence pauses a deliberation after a carried evidence motion and
+// queues the panel's requests for the filer in the outbox.
+func requestEvidence(d store.Store, motion *deliberation.MotionError, opts map[string]string, jsonOut bool, now time.Time) int {
+	caseID := motion.Transcript.CaseID
+	c, err := d.LoadCase(caseID)
+	if err != nil {
+		errorf("load case: %v", err)
+		return 1
+	}
+	pending := core.PendingDeliberation{
+		CaseID:     caseID,
+		Status:     core.PendingAwaitingEvidence,
+		Motion:     &motion.Motion,
+		Item:       motion.Motion.Item,
+		OpenedAt:   now.Format(time.RFC3339),
+		OnTimeout:  core.DecisionDefer,
+		Options:    opts,
+		Transcript: motion.Transcript,
+	}
+	pending.Transcript.Anonymization = deliberation.Sealed(motion.Transcript.Anonymization)
+	envelope := map[string]any{
+		"type":      "senate.evidence.requested",
+		"case_id":   caseID,
+		"item":      motion.Motion.Item,
+		"filed_by":  c.FiledBy,
+		"requests":  motion.Motion.Requests,
+		"queued_at": now.Format(time.RFC3339),
+	}
+	// The pending motion, the case status and the queued request commit
+	// together.
+	var status core.CaseStatus
+	err = d.Update(func(tx store.Store) error {
+		if err := tx.SavePending(pending); err != nil {
+			return fmt.Errorf("save pending: %w", err)
+		}
+		if status, err = advanceCase(tx, caseID, core.StatusAwaitingEvidence, senateActor, "evidence motion carried", now); err != nil {
+			return fmt.Errorf("case status: %w", err)
+		}
+		if err := tx.AppendOutbox(store.OutboxEvidenceRequested, envelope); err != nil {
+			return fmt.Errorf("queue evidence request: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		errorf("%v", err)
+		return 1
+	}
+
+	if jsonOut {
+		outputJSON(pendingOutput{pending, status})
+		return 0
+	}
+	fmt.Printf("case_id: %s\n", caseID)
+	fmt.Printf("case_status: %s\n", status)
+	fmt.Printf("status: %s\n", core.PendingAwaitingEvidence)
+	if pending.Item != "" {
+		fmt.Printf("item: %s\n", pending.Item)
+	}
+	for _, r := range motion.Motion.Requests {
+		fmt.Printf("request %s: %s\n", r.Perspective, r.Request)
+	}
+	fmt.Printf("amend: senate case amend --case-id %s --evidence <items>\n", caseID)
+	fmt.Printf("pending_file: %s\n", d.Location(store.KindPending, caseID))
+	return 0
+}
+
+// cmdCaseAmend answers an evidence motion: the new evidence is added to the
+// case and the same deliberation restarts from fresh initial positions,
+// keeping its start time, anonymization and motion history.
+func cmdCaseAmend(args []string) int {
+	flags := parseFlags(args)
+	caseID := strings.TrimSpace(flags["case-id"])
+	evidence := splitCSV(flags["evidence"])
+	if caseID == "" || len(evidence) == 0 {
+		errorf("usage: senate case amend --case-id <id> --evidence a,b")
+		return 1
+	}
+	d, err := openStore(flags)
+	if err != nil {
+		errorf("open store: %v", err)
+		return 1
+	}
+	defer d.Close()
+	// The amended case, its return to deliberating and the reset pending
+	// state commit together.
+	now := time.Now().UTC()
+	err = d.Update(func(tx store.Store) error {
+		pending, err := tx.LoadPending(caseID)
+		if err != nil || pending.Status != core.PendingAwaitingEvidence {
+			return fmt.Errorf("case %s is not awaiting evidence", caseID)
+		}
+		c, err := tx.LoadCase(caseID)
+		if err != nil {
+			return fmt.Errorf("load case: %w", err)
+		}
+		if c.Status == "" {
+			inferStatus(tx, &c, now)
+		}
+		c.Evidence = append(c.Evidence, evidence...)
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("case validation: %w", err)
+		}
+		if c.Status == core.StatusAwaitingEvidence {
+			if err := c.Advance(core.StatusDeliberating, now, senateActor, "evidence amended"); err != nil {
+				return err
+			}
+		}
+		if err := tx.ReplaceCase(c); err != nil {
+			return fmt.Errorf("save case: %w", err)
+		}
+
+		prior := pending.Transcript
+		if n := len(prior.Motions); n > 0 {
+			prior.Motions[n-1].Evidence = evidence
+			prior.Motions[n-1].AnsweredAt = now.Format(time.RFC3339)
+		}
+		pending.Status = core.PendingAwaitingVotes
+		pending.Motion = nil
+		pending.Round, pending.Item = "", ""
+		pending.Transcript = core.Transcript{
+			CaseID:        prior.CaseID,
+			StartedAt:     prior.StartedAt,
+			Panel:         prior.Panel,
+			JudgeModel:    prior.JudgeModel,
+			Protocol:      prior.Protocol,
+			Anonymization: prior.Anonymization,
+			Motions:       prior.Motions,
+		}
+		if err := tx.SavePending(pending); err != nil {
+			return fmt.Errorf("save pending: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		errorf("%v", err)
+		return 1
+	}
+	return resumeDeliberation(d, caseID, flagBool(args, "--json"), now)
+}
+
+// sinceLastMotion drops votes cast before the latest answered motion, so
+// human seats vote again on the amended case.
+func sinceLastMotion(votes []core.Vote, motions []core.EvidenceMotion) []core.Vote {
+	if len(motions) == 0 {
+		return votes
+	}
+	answered, err := time.Parse(time.RFC3339, motions[len(motions)-1].AnsweredAt)
+	if err != nil {
+		return votes
+	}
+	var out []core.Vote
+	for _, v := range votes {
+		if cast, err := time.Parse(time.RFC3339, v.CastAt); err == nil && cast.Before(answered) {
+			continue
+		}
+		out = append(out, v)
+	}
+	return out
+}
@@ -14,8 +14,10 @@ import (
 	"github.com/Perttulands/senate/internal/core"
 	"github.com/Perttulands/senate/internal/deliberation"
 	"github.com/Perttulands/senate/internal/handoff"
+	"github.com/Perttulands/senate/internal/ledger"
 	"github.com/Perttulands/senate/internal/precedent"
 	"github.com/Perttulands/senate/internal/store"
+	"github.com/Perttulands/senate/internal/tools"
 )
 
 const Version = "0.1.0"
@@ -45,6 +47,30 @@ func Run(args []string) int {
 		return cmdHandoff(cmdArgs)
 	case "file-case":
 		return cmdFileCase(cmdArgs)
+	case "simulate":
+		return cmdSimulate(cmdArgs)
+	case "stats":
+		return cmdStats(cmdArgs)
+	case "vote":
+		return cmdVote(cmdArgs)
+	case "resume":
+		return cmdResume(cmdArgs)
+	case "case":
+		return cmdCase(cmdArgs)
+	case "ratify":
+		return cmdRatify(cmdArgs)
+	case "migrate":
+		return cmdMigrate(cmdArgs)
+	case "verdict":
+		return cmdVerdict(cmdArgs)
+	case "audit":
+		return cmdAudit(cmdArgs)
+	case "keys":
+		return cmdKeys(cmdArgs)
+	case "verify":
+		return cmdVerify(cmdArgs)
+	case "archive":
+		return cmdArchive(cmdArgs)
 	default:
 		errorf("unknown command: %s", cmd)
 		usage()
@@ -54,12 +80,12 @@ func Run(args []string) int {
 
 func cmdDeliberate(args []string) int {
 	flags := parseFlags(args)
-	stateDir := resolveStateDir(flags["state-dir"])
-	d, err := store.New(stateDir)
+	d, err := openStore(flags)
 	if err != nil {
-		errorf("init store: %v", err)
+		errorf("open store: %v", err)
 		return 1
 	}
+	defer d.Close()
 
 	c, err := loadCase(flags["case"], flags["quick"], flags["filed-by"])
 	if err != nil {
@@ -67,77 +93,316 @@ func cmdDeliberate(args []string) int {
 		return 1
 	}
 
+	if opts := splitCSV(flags["options"]); len(opts) > 0 {
+		c.Options = opts
+	}
+	if method := strings.TrimSpace(flags["voting-method"]); method != "" {
+		c.VotingMethod = method
+	}
+	c.Conflicts = append(c.Conflicts, splitCSV(flags["conflicts"])...)
+
 	now := time.Now().UTC()
 	c.Normalize(now)
 	if err := c.Validate(); err != nil {
 		errorf("case validation: %v", err)
 		return 1
 	}
-	if err := d.SaveCase(c); err != nil {
+
+	agents := parseInt(flags["agents"], 3)
+	panel := deliberation.BuildPanel(agents, splitCSV(flags["perspectives"]), splitCSV(flags["models"]))
+	panel = append(panel, deliberation.HumanSeats(splitCSV(flags["humans"]))...)
+	opts := deliberationOptions(flags, args)
+	engine, err := newEngine(d, panel, opts)
+	if err != nil {
+		errorf("%v", err)
+		return 1
+	}
+	expedited := flagBool(args, "--expedited")
+	ensemble := parseInt(flags["ensemble"], 1)
+	if expedited && ensemble > 1 {
+		errorf("--expedited cannot be combined with --ensemble")
+		return 1
+	}
+	threshold := deliberation.DefaultEnsembleThreshold
+	if raw := strings.TrimSpace(flags["ensemble-threshold"]); raw != "" {
+		if threshold, err = strconv.ParseFloat(raw, 64); err != nil || threshold <= 0 || threshold > 1 {
+			errorf("--ensemble-threshold must be a number in (0, 1]")
+			return 1
+		}
+	}
+
+	if err := fileCase(d, &c, flags["supersedes"], core.StatusDeliberating, now); err != nil {
 		errorf("save case: %v", err)
 		return 1
 	}
+	var transcript core.Transcript
+	var verdict core.Verdict
+	if expedited {
+		transcript, verdict, err = engine.DeliberateExpedited(c, now)
+	} else if ensemble > 1 {
+		transcript, verdict, err = engine.DeliberateEnsemble(c, now, ensemble, threshold)
+	} else {
+		transcript, verdict, err = engine.Deliberate(c, now)
+	}
+	withdrawFailed(d, c.ID, err, now)
+	var ratification *core.PendingDeliberation
+	if expedited {
+		p := ratificationPending(c.ID, panel, engine.JudgeModel, opts, now)
+		ratification = &p
+	}
+	return concludeDeliberation(d, transcript, verdict, err, opts, ratification, flagBool(args, "--json"), now)
+}
 
-	agents := parseInt(flags["agents"], 3)
-	panel := deliberation.BuildPanel(agents, splitCSV(flags["perspectives"]), splitCSV(flags["models"]))
+// resumableFlags are the deliberate flags persisted with a paused deliberation.
+var resumableFlags = []string{"protocol", "seed", "tools", "tool-budget", "evidence-root", "rules", "veto", "workspace", "human-timeout", "on-timeout"}
+
+func deliberationOptions(flags map[string]string, args []string) map[string]string {
+	opts := map[string]string{}
+	for _, name := range resumableFlags {
+		if v := strings.TrimSpace(flags[name]); v != "" {
+			opts[name] = v
+		}
+	}
+	if flagBool(args, "--no-handoff") {
+		opts["no-handoff"] = "true"
+	}
+	if flagBool(args, "--anonymize") {
+		opts["anonymize"] = "true"
+	}
+	if flagBool(args, "--motions") {
+		opts["motions"] = "true"
+	}
+	if opts["seed"] == "" && (opts["anonymize"] == "true" || parseInt(flags["ensemble"], 1) > 1) {
+		opts["seed"] = strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+	return opts
+}
+
+func newEngine(d store.Store, panel []deliberation.Perspective, opts map[string]string) (*deliberation.Engine, error) {
 	engine := deliberation.New(panel)
-	transcript, verdict, err := engine.Deliberate(c, now)
+	if err := deliberation.ValidateProtocol(opts["protocol"]); err != nil {
+		return nil, err
+	}
+	engine.Protocol = opts["protocol"]
+	if raw := opts["seed"]; raw != "" {
+		seed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("seed %q must be an integer", raw)
+		}
+		engine.Seed = seed
+	}
+	engine.Anonymize = opts["anonymize"] == "true"
+	engine.Motions = opts["motions"] == "true"
+	if names := splitCSV(opts["tools"]); len(names) > 0 {
+		selected, err := tools.Select(names, tools.Defaults(d, opts["evidence-root"]))
+		if err != nil {
+			return nil, err
+		}
+		engine.Tools = selected
+		engine.ToolBudget = parseInt(opts["tool-budget"], deliberation.DefaultToolBudget)
+	}
+	if path := opts["rules"]; path != "" {
+		rules, err := deliberation.LoadRules(path)
+		if err != nil {
+			return nil, fmt.Errorf("load rules: %w", err)
+		}
+		engine.Agent = deliberation.NewRuleAgent(rules)
+	}
+	vetoes, err := parseVetoes(opts["veto"])
 	if err != nil {
-		errorf("deliberation: %v", err)
-		return 1
+		return nil, err
+	}
+	engine.Vetoes = vetoes
+	return engine, nil
+}
+
+func parseVetoes(raw string) ([]deliberation.Veto, error) {
+	var out []deliberation.Veto
+	for _, spec := range splitCSV(raw) {
+		v, err := deliberation.ParseVetoSpec(spec)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
 	}
+	return out, nil
+}
 
-	if err := d.SaveTranscript(transcript); err != nil {
-		errorf("save transcript: %v", err)
+// concludeDeliberation persists the outcome of a deliberation run: either a
+// paused state awaiting human votes or evidence, or the transcript, verdict, handoff and precedent.
+// A provisional verdict is stored together with its ratification.
+func concludeDeliberation(d store.Store, transcript core.Transcript, verdict core.Verdict, err error, opts map[string]string, ratification *core.PendingDeliberation, jsonOut bool, now time.Time) int {
+	var awaiting *deliberation.AwaitingVotesError
+	if errors.As(err, &awaiting) {
+		return pauseDeliberation(d, awaiting, opts, jsonOut, now)
+	}
+	var motion *deliberation.MotionError
+	if errors.As(err, &motion) {
+		return requestEvidence(d, motion, opts, jsonOut, now)
+	}
+	if err != nil {
+		errorf("deliberation: %v", err)
 		return 1
 	}
+	if opts["ratification"] == "true" {
+		provisional, err := d.LoadVerdict(verdict.CaseID)
+		if err != nil {
+			errorf("load provisional verdict: %v", err)
+			return 1
+		}
+		if !provisional.Provisional {
+			errorf("verdict %s is not provisional", verdict.CaseID)
+			return 1
+		}
+		verdict = ratifyVerdict(provisional, verdict, now)
+	}
+	return issueVerdict(d, transcript, verdict, opts, ratification, jsonOut, now)
+}
 
-	if !flagBool(args, "--no-handoff") {
+// issueVerdict hands a decided verdict off, signs it and stores it with its
+// transcript. When the handoff or signing fails nothing but a pending record
+// awaiting the handoff is stored, so senate resume can issue the verdict
+// later without deliberating again.
+func issueVerdict(d store.Store, transcript core.Transcript, verdict core.Verdict, opts map[string]string, ratification *core.PendingDeliberation, jsonOut bool, now time.Time) int {
+	if opts["no-handoff"] != "true" {
 		ctx, cancel := context.WithTimeout(context.Background(), 45*time.Second)
 		defer cancel()
-		workspace := flags["workspace"]
-		res, hErr := handoff.CreateBeadForVerdict(ctx, nil, workspace, verdict)
-		if hErr != nil {
+		closeProvisionalBeads(ctx, opts["workspace"], verdict)
+		if _, hErr := createHandoffs(ctx, opts["workspace"], &verdict, "", now); hErr != nil {
 			errorf("handoff: %v", hErr)
-			return 1
-		}
-		if res.Status == "created" {
-			verdict.Handoff = &core.Handoff{
-				System:    inferTargetSystem(verdict.Type),
-				BeadID:    res.BeadID,
-				Status:    res.Status,
-				CreatedAt: now.Format(time.RFC3339),
-			}
+			return holdVerdict(d, transcript, verdict, opts, ratification, now)
 		}
 	}
 
-	if err := d.SaveVerdict(verdict); err != nil {
-		errorf("save verdict: %v", err)
-		return 1
+	if err := signVerdict(d.StateRoot(), &verdict, now); err != nil {
+		errorf("sign verdict: %v", err)
+		return holdVerdict(d, transcript, verdict, opts, ratification, now)
 	}
 
-	prec := precedent.New(d.PrecedentIndexPath())
-	if err := prec.Add(precedent.FromVerdict(verdict)); err != nil {
-		errorf("save precedent: %v", err)
+	// The transcript, verdict, precedent, their ledger entries, the cleared
+	// (or scheduled ratification) pending state and the case status commit
+	// together.
+	var status core.CaseStatus
+	err := d.Update(func(tx store.Store) error {
+		if err := tx.SaveTranscript(transcript); err != nil {
+			return fmt.Errorf("save transcript: %w", err)
+		}
+		if err := recordLedger(tx, ledger.KindTranscript, transcript.CaseID, transcript, reasonDeliberated, now); err != nil {
+			return fmt.Errorf("record transcript: %w", err)
+		}
+		save, reason := tx.SaveVerdict, reasonIssued
+		if verdict.Ratification != nil {
+			save, reason = tx.ReplaceVerdict, reasonRatified
+		}
+		if err := save(verdict); err != nil {
+			return fmt.Errorf("save verdict: %w", err)
+		}
+		if err := recordLedger(tx, ledger.KindVerdict, verdict.CaseID, verdict, reason, now); err != nil {
+			return fmt.Errorf("record verdict: %w", err)
+		}
+		if err := addPrecedents(tx, verdict, now); err != nil {
+			return fmt.Errorf("save precedent: %w", err)
+		}
+		if err := tx.DeletePending(verdict.CaseID); err != nil {
+			return fmt.Errorf("clear pending: %w", err)
+		}
+		if ratification != nil {
+			if err := scheduleRatification(tx, *ratification, now); err != nil {
+				return err
+			}
+		}
+		var err error
+		if verdict.Ratification == nil {
+			if status, err = advanceCase(tx, verdict.CaseID, core.StatusDecided, senateActor, "", now); err != nil {
+				return fmt.Errorf("case status: %w", err)
+			}
+		}
+		if hasHandoff(verdict) {
+			if status, err = advanceCase(tx, verdict.CaseID, core.StatusHandedOff, senateActor, "", now); err != nil {
+				return fmt.Errorf("case status: %w", err)
+			}
+		}
+		if status == "" {
+			status = caseStatus(tx, verdict.CaseID)
+		}
+		return nil
+	})
+	if err != nil {
+		errorf("%v", err)
 		return 1
 	}
 
-	if flagBool(args, "--json") {
-		outputJSON(verdict)
+	if jsonOut {
+		outputJSON(verdictOutput{verdict, status})
 		return 0
 	}
 
 	fmt.Printf("case_id: %s\n", verdict.CaseID)
+	fmt.Printf("case_status: %s\n", status)
 	fmt.Printf("verdict: %s\n", verdict.Verdict)
+	if verdict.Outcome != "" {
+		fmt.Printf("outcome: %s\n", verdict.Outcome)
+	}
 	fmt.Printf("binding: %t\n", verdict.Binding)
-	fmt.Printf("verdict_file: %s\n", d.VerdictPath(verdict.CaseID))
-	fmt.Printf("transcript_file: %s\n", d.TranscriptPath(verdict.CaseID))
+	if verdict.Provisional {
+		fmt.Printf("provisional: true (judge alone, pending ratification)\n")
+	}
+	if r := verdict.Ratification; r != nil {
+		fmt.Printf("ratification: %s (provisional %s)\n", r.Status, r.ProvisionalVerdict)
+		if len(r.ProvisionalBeads) > 0 {
+			fmt.Printf("provisional_beads: %s\n", strings.Join(r.ProvisionalBeads, ", "))
+		}
+	}
+	if q := transcript.Quorum; q != nil && (q.Recused > 0 || q.Abstained > 0 || !q.Met) {
+		fmt.Printf("quorum: %d voting of %d eligible (%d required, %d recused, %d abstained)\n", q.Voting, q.Eligible, q.Required, q.Recused, q.Abstained)
+	}
+	if e := verdict.Ensemble; e != nil {
+		fmt.Printf("ensemble: %d/%d agree (%.2f, threshold %.2f)\n", e.Verdicts[verdict.Verdict], e.Size, e.Agreement, e.Threshold)
+	}
+	for _, it := range verdict.Items {
+		decision := string(it.Verdict)
+		if it.Outcome != "" {
+			decision += " (" + it.Outcome + ")"
+		}
+		fmt.Printf("item %s: %s binding=%t\n", it.ItemID, decision, it.Binding)
+		if it.Handoff != nil && it.Handoff.BeadID != "" {
+			fmt.Printf("item %s handoff_bead: %s\n", it.ItemID, it.Handoff.BeadID)
+		}
+	}
+	fmt.Printf("verdict_file: %s\n", d.Location(store.KindVerdict, verdict.CaseID))
+	fmt.Printf("transcript_file: %s\n", d.Location(store.KindTranscript, verdict.CaseID))
 	if verdict.Handoff != nil && verdict.Handoff.BeadID != "" {
 		fmt.Printf("handoff_bead: %s\n", verdict.Handoff.BeadID)
 	}
+	if ratification != nil {
+		fmt.Printf("ratification: scheduled (senate ratify --case-id %s)\n", verdict.CaseID)
+	}
 	return 0
 }
 
+// holdVerdict stores a verdict that could not be handed off or signed as a
+// pending record awaiting the handoff, together with its transcript and any
+// ratification it schedules. The case stays deliberating until senate resume
+// issues it.
+func holdVerdict(d store.Store, transcript core.Transcript, verdict core.Verdict, opts map[string]string, ratification *core.PendingDeliberation, now time.Time) int {
+	pending := core.PendingDeliberation{
+		CaseID:       verdict.CaseID,
+		Status:       core.PendingAwaitingHandoff,
+		OpenedAt:     now.Format(time.RFC3339),
+		OnTimeout:    core.DecisionDefer,
+		Options:      opts,
+		Transcript:   transcript,
+		Verdict:      &verdict,
+		Ratification: ratification,
+	}
+	if err := d.SavePending(pending); err != nil {
+		errorf("save pending: %v", err)
+		return 1
+	}
+	errorf("verdict for %s held until the handoff succeeds; retry with senate resume --case-id %s", verdict.CaseID, verdict.CaseID)
+	return 1
+}
+
 func cmdPrecedent(args []string) int {
 	if len(args) == 0 {
 		errorf("usage: senate precedent search --query <text> [flags]")
@@ -149,14 +414,14 @@ func cmdPrecedent(args []string) int {
 	switch sub {
 	case "search":
 		flags := parseFlags(args)
-		stateDir := resolveStateDir(flags["state-dir"])
-		d, err := store.New(stateDir)
+		d, err := openStore(flags)
 		if err != nil {
-			errorf("init store: %v", err)
+			errorf("open store: %v", err)
 			return 1
 		}
+		defer d.Close()
 		query := strings.TrimSpace(flags["query"])
-		results, err := precedent.New(d.PrecedentIndexPath()).Search(query, precedent.SearchOptions{
+		results, err := d.SearchPrecedents(query, precedent.SearchOptions{
 			Type:    strings.TrimSpace(flags["type"]),
 			Verdict: parseDecision(flags["verdict"]),
 			Limit:   parseInt(flags["limit"], 20),
@@ -187,93 +452,403 @@ func cmdHandoff(args []string) int {
 	flags := parseFlags(args)
 	caseID := strings.TrimSpace(flags["case-id"])
 	if caseID == "" {
-		errorf("usage: senate handoff --case-id <id> [--workspace <path>] [--state-dir <path>]")
+		errorf("usage: senate handoff --case-id <id> [--item <id>] [--workspace <path>] [--state-dir <path>]")
 		return 1
 	}
 
-	d, err := store.New(resolveStateDir(flags["state-dir"]))
+	d, err := openStore(flags)
 	if err != nil {
-		errorf("init store: %v", err)
+		errorf("open store: %v", err)
 		return 1
 	}
+	defer d.Close()
 
 	v, err := d.LoadVerdict(caseID)
 	if err != nil {
 		errorf("load verdict: %v", err)
 		return 1
 	}
-	if v.Handoff != nil && strings.TrimSpace(v.Handoff.BeadID) != "" {
-		fmt.Printf("handoff already exists: %s\n", v.Handoff.BeadID)
-		return 0
-	}
 
+	now := time.Now().UTC()
 	ctx, cancel := context.WithTimeout(context.Background(), 45*time.Second)
 	defer cancel()
-	res, err := handoff.CreateBeadForVerdict(ctx, nil, flags["workspace"], v)
+	results, err := createHandoffs(ctx, flags["workspace"], &v, strings.TrimSpace(flags["item"]), now)
 	if err != nil {
 		errorf("handoff: %v", err)
 		return 1
 	}
+	created := false
+	for _, r := range results {
+		created = created || r.Status == "created"
+	}
+	if created {
+		if err := signVerdict(d.StateRoot(), &v, now); err != nil {
+			errorf("sign verdict: %v", err)
+			return 1
+		}
+		err := d.Update(func(tx store.Store) error {
+			if err := tx.ReplaceVerdict(v); err != nil {
+				return fmt.Errorf("save verdict: %w", err)
+			}
+			if err := recordLedger(tx, ledger.KindVerdict, v.CaseID, v, reasonHandoff, now); err != nil {
+				return fmt.Errorf("record verdict: %w", err)
+			}
+			if err := addPrecedents(tx, v, now); err != nil {
+				return fmt.Errorf("update precedent index: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			errorf("%v", err)
+			return 1
+		}
+	}
+	status := caseStatus(d, caseID)
+	if created && status == core.StatusDecided {
+		if status, err = advanceCase(d, caseID, core.StatusHandedOff, senateActor, "", now); err != nil {
+			errorf("case status: %v", err)
+			return 1
+		}
+	}
+	for i := range results {
+		results[i].CaseStatus = status
+	}
+	if flagBool(args, "--json") {
+		if len(results) == 1 && results[0].Item == "" {
+			outputJSON(results[0])
+		} else {
+			outputJSON(results)
+		}
+		return 0
+	}
+	fmt.Printf("case_status: %s\n", status)
+	for _, r := range results {
+		prefix := ""
+		if r.Item != "" {
+			prefix = "item " + r.Item + " "
+		}
+		if r.Status == "exists" {
+			fmt.Printf("%shandoff already exists: %s\n", prefix, r.BeadID)
+			continue
+		}
+		fmt.Printf("%shandoff status: %s\n", prefix, r.Status)
+		if r.BeadID != "" {
+			fmt.Printf("%shandoff bead: %s\n", prefix, r.BeadID)
+		}
+	}
+	return 0
+}
+
+// handoffResult is one bead creation attempt; Item is set for sub-questions.
+type handoffResult struct {
+	Item string `json:"item,omitempty"`
+	handoff.Result
+	CaseStatus core.CaseStatus `json:"case_status,omitempty"`
+}
+
+// createHandoffs files beads for a verdict's binding work: one for a plain
+// verdict, or one per sub-question of a compound verdict (only item, when
+// set). Units that already have a bead are reported with status "exists".
+func createHandoffs(ctx context.Context, workspace string, v *core.Verdict, item string, now time.Time) ([]handoffResult, error) {
+	if len(v.Items) == 0 {
+		if item != "" {
+			return nil, fmt.Errorf("verdict %s has no sub-questions", v.CaseID)
+		}
+		res, h, err := handoffUnit(ctx, workspace, *v, v.Handoff, now)
+		if err != nil {
+			return nil, err
+		}
+		v.Handoff = h
+		return []handoffResult{{Result: res}}, nil
+	}
+	var out []handoffResult
+	for i := range v.Items {
+		it := &v.Items[i]
+		if item != "" && it.ItemID != item {
+			continue
+		}
+		unit, _ := v.Item(it.ItemID)
+		res, h, err := handoffUnit(ctx, workspace, unit, it.Handoff, now)
+		if err != nil {
+			return out, fmt.Errorf("item %s: %w", it.ItemID, err)
+		}
+		it.Handoff = h
+		out = append(out, handoffResult{Item: it.ItemID, Result: res})
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("verdict %s has no sub-question %s", v.CaseID, item)
+	}
+	return out, nil
+}
+
+func handoffUnit(ctx context.Context, workspace string, v core.Verdict, existing *core.Handoff, now time.Time) (handoff.Result, *core.Handoff, error) {
+	if existing != nil && strings.TrimSpace(existing.BeadID) != "" {
+		return handoff.Result{BeadID: existing.BeadID, Status: "exists"}, existing, nil
+	}
+	res, err := handoff.CreateBeadForVerdict(ctx, nil, workspace, v)
+	if err != nil {
+		return res, existing, err
+	}
 	if res.Status == "created" {
-		v.Handoff = &core.Handoff{
+		existing = &core.Handoff{
 			System:    inferTargetSystem(v.Type),
 			BeadID:    res.BeadID,
-			Status:    "created",
-			CreatedAt: time.Now().UTC().Format(time.RFC3339),
+			Status:    res.Status,
+			CreatedAt: now.Format(time.RFC3339),
+		}
+	}
+	return res, existing, nil
+}
+
+// precedentLedger is what addPrecedents writes to.
+type precedentLedger interface {
+	store.PrecedentStore
+	store.LedgerStore
+}
+
+// addPrecedents indexes a verdict's precedent records and records each in
+// the audit ledger. Callers run it inside Update, like recordLedger.
+func addPrecedents(tx precedentLedger, v core.Verdict, now time.Time) error {
+	for _, rec := range precedent.FromVerdictItems(v) {
+		if err := tx.AddPrecedent(rec); err != nil {
+			return err
+		}
+		if err := recordLedger(tx, ledger.KindPrecedent, rec.CaseID, rec, reasonIssued, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cmdSimulate replays a case against several panel configurations in memory.
+// It only reads from the state directory and never writes to it.
+func cmdSimulate(args []string) int {
+	flags := parseFlags(args)
+	ref := strings.TrimSpace(flags["case"])
+	specs := splitCSV(flags["panels"])
+	if ref == "" || len(specs) == 0 {
+		errorf("usage: senate simulate --case <file|case-id> --panels a,b,c [--runs N] [--noise P] [--seed N]")
+		return 1
+	}
+
+	var baseline *core.Verdict
+	var c core.Case
+	if _, err := os.Stat(ref); err == nil {
+		c, err = loadCase(ref, "", flags["filed-by"])
+		if err != nil {
+			errorf("load case: %v", err)
+			return 1
+		}
+		c.Normalize(time.Now().UTC())
+	} else {
+		d, err := openStore(flags)
+		if err != nil {
+			errorf("open store: %v", err)
+			return 1
+		}
+		defer d.Close()
+		c, err = d.LoadCase(ref)
+		if err != nil {
+			errorf("load case: %v", err)
+			return 1
+		}
+		if v, err := d.LoadVerdict(ref); err == nil {
+			baseline = &v
+		}
+	}
+
+	models := splitCSV(flags["models"])
+	configs := make([]deliberation.PanelConfig, 0, len(specs))
+	for _, spec := range specs {
+		panel, err := deliberation.ParsePanelSpec(spec, models)
+		if err != nil {
+			errorf("panel: %v", err)
+			return 1
+		}
+		configs = append(configs, deliberation.PanelConfig{Name: spec, Panel: panel})
+	}
+	vetoes, err := parseVetoes(flags["veto"])
+	if err != nil {
+		errorf("%v", err)
+		return 1
+	}
+	protocol := strings.TrimSpace(flags["protocol"])
+	if err := deliberation.ValidateProtocol(protocol); err != nil {
+		errorf("%v", err)
+		return 1
+	}
+	opts := deliberation.SimulateOptions{
+		Runs:     parseInt(flags["runs"], 1),
+		Protocol: protocol,
+		Baseline: baseline,
+		Vetoes:   vetoes,
+		Seed:     time.Now().UnixNano(),
+	}
+	// The built-in agent is deterministic, so repeated runs vary only by noise.
+	if opts.Runs > 1 {
+		opts.Noise = 0.2
+	}
+	if raw := strings.TrimSpace(flags["noise"]); raw != "" {
+		if opts.Noise, err = strconv.ParseFloat(raw, 64); err != nil || opts.Noise < 0 || opts.Noise > 1 {
+			errorf("--noise must be a number in [0, 1]")
+			return 1
+		}
+	}
+	if raw := strings.TrimSpace(flags["seed"]); raw != "" {
+		if opts.Seed, err = strconv.ParseInt(raw, 10, 64); err != nil {
+			errorf("--seed must be an integer")
+			return 1
+		}
+	}
+	if path := strings.TrimSpace(flags["rules"]); path != "" {
+		rules, err := deliberation.LoadRules(path)
+		if err != nil {
+			errorf("load rules: %v", err)
+			return 1
+		}
+		opts.Agent = deliberation.NewRuleAgent(rules)
+	}
+
+	report, err := deliberation.Simulate(c, configs, opts)
+	if err != nil {
+		errorf("simulate: %v", err)
+		return 1
+	}
+	if flagBool(args, "--json") {
+		outputJSON(report)
+		return 0
+	}
+	fmt.Printf("case_id: %s\n", report.CaseID)
+	fmt.Printf("runs: %d\n", report.Runs)
+	if report.Noise > 0 {
+		fmt.Printf("noise: %.2f (seed %d)\n", report.Noise, report.Seed)
+	}
+	fmt.Printf("baseline: %s (%s)\n", report.Baseline, report.BaselineVerdict)
+	for _, p := range report.Panels {
+		fmt.Printf("panel %s [%s]: modal=%s consistency=%.2f baseline_agreement=%.2f seat_agreement=%.2f\n",
+			p.Panel, strings.Join(p.Seats, ","), p.Modal, p.Consistency, p.BaselineAgreement, p.SeatAgreement)
+		for _, d := range []core.Decision{core.DecisionApprove, core.DecisionReject, core.DecisionAmend, core.DecisionDefer} {
+			if n := p.Verdicts[d]; n > 0 {
+				fmt.Printf("  %s: %d/%d\n", d, n, report.Runs)
+			}
 		}
-		if err := d.SaveVerdict(v); err != nil {
-			errorf("save verdict: %v", err)
+		for _, f := range p.Flips {
+			fmt.Printf("  flipped: %s %s -> %s\n", f.Seat, f.From, f.To)
+		}
+	}
+	for _, a := range report.PairwiseAgreement {
+		fmt.Printf("agreement %s vs %s: %.2f\n", a.A, a.B, a.Rate)
+	}
+	return 0
+}
+
+type caseMetrics struct {
+	CaseID  string       `json:"case_id"`
+	Metrics core.Metrics `json:"metrics"`
+}
+
+type statsReport struct {
+	Transcripts int           `json:"transcripts"`
+	Mean        core.Metrics  `json:"mean"`
+	Cases       []caseMetrics `json:"cases"`
+}
+
+// cmdStats reports deliberation quality metrics for stored transcripts.
+// Transcripts written before metrics existed are scored on the fly.
+func cmdStats(args []string) int {
+	flags := parseFlags(args)
+	d, err := openStore(flags)
+	if err != nil {
+		errorf("open store: %v", err)
+		return 1
+	}
+	defer d.Close()
+
+	ids := []string{strings.TrimSpace(flags["case-id"])}
+	if ids[0] == "" {
+		ids, err = d.TranscriptIDs()
+		if err != nil {
+			errorf("list transcripts: %v", err)
 			return 1
 		}
-		if err := precedent.New(d.PrecedentIndexPath()).Add(precedent.FromVerdict(v)); err != nil {
-			errorf("update precedent index: %v", err)
+	}
+
+	report := statsReport{Cases: make([]caseMetrics, 0, len(ids))}
+	all := make([]core.Metrics, 0, len(ids))
+	for _, id := range ids {
+		t, err := d.LoadTranscript(id)
+		if err != nil {
+			errorf("load transcript: %v", err)
 			return 1
 		}
+		m := t.Metrics
+		if m == nil {
+			c, _ := d.LoadCase(id)
+			computed := deliberation.ComputeMetrics(c, t)
+			m = &computed
+		}
+		report.Cases = append(report.Cases, caseMetrics{CaseID: id, Metrics: *m})
+		all = append(all, *m)
 	}
+	report.Transcripts = len(all)
+	report.Mean = deliberation.MeanMetrics(all)
+
 	if flagBool(args, "--json") {
-		outputJSON(res)
+		outputJSON(report)
+		return 0
+	}
+	if report.Transcripts == 0 {
+		fmt.Println("no transcripts")
 		return 0
 	}
-	fmt.Printf("handoff status: %s\n", res.Status)
-	if res.BeadID != "" {
-		fmt.Printf("handoff bead: %s\n", res.BeadID)
+	for _, cm := range report.Cases {
+		printMetrics(cm.CaseID, cm.Metrics)
+	}
+	if report.Transcripts > 1 {
+		printMetrics(fmt.Sprintf("mean (%d transcripts)", report.Transcripts), report.Mean)
 	}
 	return 0
 }
 
+func printMetrics(label string, m core.Metrics) {
+	fmt.Printf("%s: agreement %.2f->%.2f stance_changes=%d challenge_coverage=%.2f evidence_citation=%.2f reasoning_words=%.1f abstentions=%d\n",
+		label, m.InitialAgreement, m.FinalAgreement, m.StanceChanges, m.ChallengeCoverage, m.EvidenceCitationRate, m.MeanReasoningWords, m.Abstentions)
+}
+
 // cmdFileCase is a SEN-002-compatible stub interface.
 // Relay integration is owned by a separate bead and agent.
 func cmdFileCase(args []string) int {
 	flags := parseFlags(args)
-	stateDir := resolveStateDir(flags["state-dir"])
-	d, err := store.New(stateDir)
+	d, err := openStore(flags)
 	if err != nil {
-		errorf("init store: %v", err)
+		errorf("open store: %v", err)
 		return 1
 	}
+	defer d.Close()
 	c, err := loadCase(flags["case"], flags["quick"], flags["filed-by"])
 	if err != nil {
 		errorf("load case: %v", err)
 		return 1
 	}
-	c.Normalize(time.Now().UTC())
+	now := time.Now().UTC()
+	c.Normalize(now)
 	if err := c.Validate(); err != nil {
 		errorf("case validation: %v", err)
 		return 1
 	}
-	if err := d.SaveCase(c); err != nil {
+	if err := fileCase(d, &c, flags["supersedes"], core.StatusQueued, now); err != nil {
 		errorf("save case: %v", err)
 		return 1
 	}
 
 	envelope := map[string]any{
 		"type":                   "senate.case.filed",
-		"filed_at":               time.Now().UTC().Format(time.RFC3339),
+		"filed_at":               now.Format(time.RFC3339),
 		"relay_integration_stub": true,
 		"case_id":                c.ID,
 		"case":                   c,
 	}
-	if err := appendJSONL(d.RelayOutboxPath(), envelope); err != nil {
+	if err := d.AppendOutbox(store.OutboxCaseFiled, envelope); err != nil {
 		errorf("queue relay outbox: %v", err)
 		return 1
 	}
@@ -283,7 +858,8 @@ func cmdFileCase(args []string) int {
 		return 0
 	}
 	fmt.Printf("queued case filing stub: %s\n", c.ID)
-	fmt.Printf("outbox: %s\n", d.RelayOutboxPath())
+	fmt.Printf("case_status: %s\n", c.Status)
+	fmt.Printf("outbox: %s\n", d.Location(store.KindOutbox, store.OutboxCaseFiled))
 	fmt.Println("relay integration is intentionally stubbed (SEN-002 handled by another agent)")
 	return 0
 }
@@ -314,24 +890,6 @@ func loadCase(caseFile, quickQuestion, filedBy string) (core.Case, error) {
 	return c, nil
 }
 
-func appendJSONL(path string, value any) error {
-	line, err := json.Marshal(value)
-	if err != nil {
-		return err
-	}
-	line = append(line, '\n')
-	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
-		return err
-	}
-	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-	_, err = f.Write(line)
-	return err
-}
-
 func parseFlags(args []string) map[string]string {
 	flags := map[string]string{}
 	for i := 0; i < len(args); i++ {
@@ -387,19 +945,13 @@ func parseInt(raw string, fallback int) int {
 }
 
 func parseDecision(raw string) core.Decision {
-	raw = strings.TrimSpace(strings.ToLower(raw))
-	switch raw {
-	case "approve", "approved":
-		return core.DecisionApprove
-	case "reject", "rejected":
-		return core.DecisionReject
-	case "amend", "amended":
-		return core.DecisionAmend
-	case "defer", "deferred":
-		return core.DecisionDefer
-	default:
-		return ""
-	}
+	return core.ParseDecision(raw)
+}
+
+// openStore opens the state root named by --state-dir or SENATE_STATE_DIR:
+// the embedded database once migrated, otherwise the directory layout.
+func openStore(flags map[string]string) (store.Store, error) {
+	return store.Open(resolveStateDir(flags["state-dir"]))
 }
 
 func resolveStateDir(fromFlag string) string {
@@ -432,7 +984,25 @@ COMMANDS:
   senate deliberate --case <file> [flags]      Run deliberation and synthesize a binding verdict
   senate file-case --case <file> [flags]       Queue a case filing stub for Relay (SEN-002 boundary)
   senate precedent search --query <text>        Search stored verdict precedents
-  senate handoff --case-id <id>                 Trigger implementation bead creation from stored verdict
+  senate handoff --case-id <id> [--item <id>]   Trigger implementation bead creation from stored verdict
+  senate simulate --case <file|id> --panels a,b Compare verdicts across panel configurations (no writes)
+  senate stats [--case-id <id>]                 Deliberation quality metrics per transcript
+  senate vote --case-id <id> --seat <seat>      Cast a human seat vote and resume deliberation
+  senate resume --case-id <id>                  Resume a paused deliberation (applies vote timeouts)
+  senate case list [--status s] [--type t]      List stored cases (also --filed-by, --since, --until, --limit)
+  senate case show <id>                         Show a case with its lifecycle history
+  senate case amend --case-id <id> --evidence a Answer an evidence motion and resume deliberation
+  senate case transition --case-id <id> --to s  Record a lifecycle change (e.g. implemented, withdrawn)
+  senate verdict list [--verdict d] [--type t]  List stored verdicts (also --since, --until, --limit)
+  senate verdict show <id> [--transcript]       Show a stored verdict, optionally with its transcript
+  senate ratify [--case-id <id>]                Ratify expedited verdicts with their full panel
+  senate migrate [--dry-run] [--adopt-unrecorded]
+                                                Rewrite stored documents to the current schema version
+  senate migrate --to db|dir                    Move state between the directory layout and the embedded database
+  senate audit verify                           Check the ledger chain and every verdict, transcript and precedent against it
+  senate keys init|rotate|export                Manage the ed25519 key verdicts are signed with
+  senate verify <verdict.json|id> [--keys <f>]  Check a verdict's signature against the (exported) keyring
+  senate archive [--older-than <days>]          Move old transcripts into compressed monthly bundles (default 90)
   senate version                                Print version
 
 FLAGS:
@@ -441,11 +1011,49 @@ FLAGS:
 
 DELIBERATE FLAGS:
   --quick <question>          Build ad-hoc case from a single question
+  --supersedes <case-id>      File as a replacement for an existing case (also for file-case)
   --agents <n>                Number of panel agents (default 3)
   --perspectives a,b,c        Override perspective labels
   --models m1,m2              Override model labels
+  --protocol <name>           senate (default), delphi, debate, or vote
+  --anonymize                 Show positions to other seats under shuffled anonymous labels
+  --seed <n>                  Seed for --anonymize and --ensemble (default: random, recorded)
+  --expedited                 Judge decides alone; the provisional verdict binds until the panel ratifies it
+  --motions                   Let a panel majority pause the case to request more evidence from the filer
+  --ensemble <k>              Run k independent panels and aggregate their verdicts
+                              (no effect with the built-in rule agent: every run agrees)
+  --ensemble-threshold <f>    Run agreement below which the verdict is non-binding (default 0.67)
+  --tools a,b|all             Tools seats may call: search_precedents, read_evidence, list_verdicts
+  --tool-budget <n>           Tool calls allowed per seat per case (default 3)
+  --evidence-root <path>      Directory evidence files are read from (default: current directory)
+  --rules <file>              Scoring rules JSON for the offline engine (default: built-in)
+  --options a,b,c             Decide between options by ranked voting
+  --voting-method <m>         irv (default), borda, or condorcet (falls back to borda)
+  --humans a,b                Add human senator seats that vote via senate vote
+  --conflicts a,b             Seats (agent ids or senator names) that must recuse; the filer always recuses
+  --veto seat:decision[:fallback][@topic+topic]
+                              Let a seat veto a verdict (fallback default deferred), comma-separated
+  --human-timeout <duration>  Deadline per round for human votes (e.g. 48h)
+  --on-timeout <decision>     Stance recorded for seats that miss the deadline (default deferred)
   --workspace <path>          Workspace path for bd handoff creation
   --no-handoff                Disable SEN-006 automatic bead creation
+
+VOTE FLAGS:
+  --stance <decision>         approved|rejected|amended|deferred, or abstained (excluded from tallies)
+  --reasoning <text>          Rationale for the stance (required)
+  --outcome <value>           Typed outcome for cases with an outcome schema (e.g. P1, 70)
+  --ranking a,b,c             Option ranking for multi-option cases, most preferred first
+  --concerns <text>           Optional concerns
+  --motion <text>             Move for more evidence (initial round; needs a panel majority)
+
+SIMULATE FLAGS:
+  --panels a,b,c              Panel presets (default, full, cautious, delivery) or "+"-joined perspectives
+  --runs <n>                  Runs per panel, each with rotated seat order (default 1)
+  --noise <p>                 Chance per run that a seat's stance moves one step (default 0.2 with --runs > 1)
+  --seed <n>                  Seed for --noise (default: random, reported)
+  --protocol <name>           Deliberation protocol, as for deliberate
+  --rules <file>              Scoring rules JSON for the offline engine
+  --veto <specs>              Veto seats, as for deliberate
 `)
 }
 
@@ -1,6 +1,21 @@
 package cli
 
-import "testing"
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/ledger"
+	"github.com/Perttulands/senate/internal/signing"
+	"github.com/Perttulands/senate/internal/store"
+)
 
 func TestParseDecision(t *testing.T) {
 	if got := parseDecision("approve"); got == "" {
@@ -17,3 +32,791 @@ func TestSplitCSV(t *testing.T) {
 		t.Fatalf("expected 3 parts, got %d", len(parts))
 	}
 }
+
+func TestRecordedBallotsAppliesTimeoutPolicy(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	seat := core.PanelMember{AgentID: "human-4", Perspective: "alice", Kind: core.SeatHuman}
+	pending := core.PendingDeliberation{
+		Round:     core.RoundInitial,
+		Deadline:  now.Add(-time.Minute).Format(time.RFC3339),
+		OnTimeout: core.DecisionDefer,
+	}
+	b := recordedBallots{
+		votes: []core.Vote{
+			{Seat: "alice", Round: core.RoundInitial, Stance: core.DecisionApprove, Reasoning: "by name"},
+			{Seat: "human-4", Round: core.RoundFinal, Stance: core.DecisionApprove, Reasoning: "ok"},
+		},
+		pending: pending,
+		now:     now,
+	}
+	a, ok := b.Ballot(seat, core.RoundInitial, "")
+	if !ok || a.Stance != core.DecisionDefer {
+		t.Fatalf("expected timeout stance, not a vote cast under the perspective name, got %+v ok=%t", a, ok)
+	}
+	a, ok = b.Ballot(seat, core.RoundFinal, "")
+	if !ok || a.Stance != core.DecisionApprove {
+		t.Fatalf("expected recorded vote by seat, got %+v ok=%t", a, ok)
+	}
+}
+
+func TestCaseAmendResumesAfterEvidenceMotion(t *testing.T) {
+	dir := t.TempDir()
+	base := []string{"senate", "deliberate", "--quick", "Should we adopt the cache layer?", "--motions", "--no-handoff", "--state-dir", dir}
+	if code := Run(base); code != 0 {
+		t.Fatalf("deliberate exited %d", code)
+	}
+	d, err := store.New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := d.PendingIDs()
+	if err != nil || len(ids) != 1 {
+		t.Fatalf("expected one pending case, got %v (%v)", ids, err)
+	}
+	pending, err := d.LoadPending(ids[0])
+	if err != nil || pending.Status != core.PendingAwaitingEvidence || pending.Motion == nil {
+		t.Fatalf("expected case awaiting evidence, got %+v (%v)", pending, err)
+	}
+	if code := Run([]string{"senate", "resume", "--case-id", ids[0], "--state-dir", dir}); code == 0 {
+		t.Fatal("expected resume to refuse a case awaiting evidence")
+	}
+	if c, err := d.LoadCase(ids[0]); err != nil || c.Status != core.StatusAwaitingEvidence {
+		t.Fatalf("expected case status awaiting_evidence, got %s (%v)", c.Status, err)
+	}
+
+	if code := Run([]string{"senate", "case", "amend", "--case-id", ids[0], "--evidence", "state/reports/cache.md,bead:athena-7", "--state-dir", dir}); code != 0 {
+		t.Fatalf("amend exited %d", code)
+	}
+	if _, err := d.LoadVerdict(ids[0]); err != nil {
+		t.Fatalf("expected verdict after amendment: %v", err)
+	}
+	c, err := d.LoadCase(ids[0])
+	if err != nil || c.Status != core.StatusDecided {
+		t.Fatalf("expected amended case decided, got %s (%v)", c.Status, err)
+	}
+	var path []core.CaseStatus
+	for _, tr := range c.History {
+		path = append(path, tr.To)
+	}
+	want := []core.CaseStatus{core.StatusFiled, core.StatusDeliberating, core.StatusAwaitingEvidence, core.StatusDeliberating, core.StatusDecided}
+	if fmt.Sprint(path) != fmt.Sprint(want) {
+		t.Fatalf("expected history %v, got %v", want, path)
+	}
+	transcript, err := d.LoadTranscript(ids[0])
+	if err != nil || len(transcript.Motions) != 1 || transcript.Motions[0].AnsweredAt == "" {
+		t.Fatalf("expected answered motion in transcript, got %+v (%v)", transcript.Motions, err)
+	}
+}
+
+func TestExpeditedVerdictIsRatifiedByFullPanel(t *testing.T) {
+	dir := t.TempDir()
+	if code := Run([]string{"senate", "deliberate", "--quick", "Should we ship the hotfix?", "--expedited", "--no-handoff", "--state-dir", dir}); code != 0 {
+		t.Fatalf("deliberate exited %d", code)
+	}
+	d, err := store.New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := d.PendingIDs()
+	if err != nil || len(ids) != 1 {
+		t.Fatalf("expected a scheduled ratification, got %v (%v)", ids, err)
+	}
+	if v, err := d.LoadVerdict(ids[0]); err != nil || !v.Provisional {
+		t.Fatalf("expected provisional verdict, got %+v (%v)", v, err)
+	}
+
+	if code := Run([]string{"senate", "ratify", "--state-dir", dir}); code != 0 {
+		t.Fatalf("ratify exited %d", code)
+	}
+	v, err := d.LoadVerdict(ids[0])
+	if err != nil || v.Provisional || v.Ratification == nil {
+		t.Fatalf("expected ratified verdict, got %+v (%v)", v, err)
+	}
+	if left, _ := d.PendingIDs(); len(left) != 0 {
+		t.Fatalf("expected ratification to clear pending, got %v", left)
+	}
+}
+
+func TestOverturnedRatificationListsProvisionalBeads(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	provisional := core.Verdict{CaseID: "senate-9", Verdict: core.DecisionApprove, Provisional: true, Handoff: &core.Handoff{System: "athena", BeadID: "athena-1", Status: "created"}}
+	confirmed := ratifyVerdict(provisional, core.Verdict{CaseID: "senate-9", Verdict: core.DecisionApprove}, now)
+	if confirmed.Handoff == nil || confirmed.Handoff.BeadID != "athena-1" || len(confirmed.Ratification.ProvisionalBeads) != 0 {
+		t.Fatalf("expected a confirmed verdict to keep its bead, got %+v", confirmed)
+	}
+	overturned := ratifyVerdict(provisional, core.Verdict{CaseID: "senate-9", Verdict: core.DecisionReject}, now)
+	if overturned.Handoff != nil || len(overturned.Ratification.ProvisionalBeads) != 1 || overturned.Ratification.ProvisionalBeads[0] != "athena-1" {
+		t.Fatalf("expected an overturned verdict to list the provisional bead, got %+v", overturned.Ratification)
+	}
+}
+
+func TestDeliberateUsesMigratedDatabase(t *testing.T) {
+	dir := t.TempDir()
+	if code := Run([]string{"senate", "migrate", "--to", "db", "--state-dir", dir}); code != 0 {
+		t.Fatalf("migrate exited %d", code)
+	}
+	if code := Run([]string{"senate", "deliberate", "--quick", "Should we add a cache?", "--no-handoff", "--state-dir", dir}); code != 0 {
+		t.Fatalf("deliberate exited %d", code)
+	}
+	d, err := store.Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	if _, ok := d.(*store.DB); !ok {
+		t.Fatalf("expected database backend, got %T", d)
+	}
+	if ids, err := d.VerdictIDs(); err != nil || len(ids) != 1 {
+		t.Fatalf("expected verdict stored in the database, got %v (%v)", ids, err)
+	}
+}
+
+func TestConcurrentDeliberationsShareStateDir(t *testing.T) {
+	dir := t.TempDir()
+	const n = 12
+	var wg sync.WaitGroup
+	codes := make([]int, n)
+	for i := 0; i < n; i++ {
+		c := core.Case{ID: fmt.Sprintf("senate-concurrent-%02d", i), Type: "general", Summary: "Parallel case", Question: "Should we run this in parallel?"}
+		data, err := json.Marshal(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(t.TempDir(), "case.json")
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			codes[i] = Run([]string{"senate", "deliberate", "--case", path, "--no-handoff", "--state-dir", dir})
+		}(i)
+	}
+	wg.Wait()
+	for i, code := range codes {
+		if code != 0 {
+			t.Fatalf("deliberation %d exited %d", i, code)
+		}
+	}
+
+	d, err := store.New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := d.VerdictIDs()
+	if err != nil || len(ids) != n {
+		t.Fatalf("expected %d verdicts, got %v (%v)", n, ids, err)
+	}
+	for _, id := range ids {
+		if _, err := d.LoadVerdict(id); err != nil {
+			t.Fatalf("verdict %s: %v", id, err)
+		}
+	}
+	records, err := d.LoadPrecedents()
+	if err != nil || len(records) != n {
+		t.Fatalf("expected %d intact precedent records, got %d (%v)", n, len(records), err)
+	}
+	temps, err := filepath.Glob(filepath.Join(dir, "*", "*.tmp"))
+	if err != nil || len(temps) > 0 {
+		t.Fatalf("expected no leftover temp files, got %v (%v)", temps, err)
+	}
+}
+
+func TestRefilingCaseIDRequiresSupersedes(t *testing.T) {
+	dir := t.TempDir()
+	data, err := json.Marshal(core.Case{ID: "senate-001", Type: "general", Summary: "Adopt the cache", Question: "Should we adopt the cache layer?"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "case.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	base := []string{"senate", "deliberate", "--case", path, "--no-handoff", "--state-dir", dir}
+	if code := Run(base); code != 0 {
+		t.Fatalf("deliberate exited %d", code)
+	}
+	if code := Run(base); code == 0 {
+		t.Fatal("expected refiling an existing case ID to be refused")
+	}
+	if code := Run(append(base, "--supersedes", "senate-001")); code != 0 {
+		t.Fatalf("superseding deliberate exited %d", code)
+	}
+
+	d, err := store.New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := d.CaseIDs()
+	if err != nil || len(ids) != 2 {
+		t.Fatalf("expected original and superseding case, got %v (%v)", ids, err)
+	}
+	for _, id := range ids {
+		if id == "senate-001" {
+			if c, err := d.LoadCase(id); err != nil || c.Status != core.StatusSuperseded {
+				t.Fatalf("expected senate-001 to be superseded, got %+v (%v)", c, err)
+			}
+			continue
+		}
+		if c, err := d.LoadCase(id); err != nil || c.Supersedes != "senate-001" {
+			t.Fatalf("expected %s to supersede senate-001, got %+v (%v)", id, c, err)
+		}
+	}
+	if ids, err := d.VerdictIDs(); err != nil || len(ids) != 2 {
+		t.Fatalf("expected both verdicts kept, got %v (%v)", ids, err)
+	}
+}
+
+func TestInvalidDeliberateFlagsFileNoCase(t *testing.T) {
+	dir := t.TempDir()
+	data, err := json.Marshal(core.Case{ID: "senate-002", Type: "general", Summary: "Adopt the cache", Question: "Should we adopt the cache layer?"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "case.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	base := []string{"senate", "deliberate", "--case", path, "--no-handoff", "--state-dir", dir}
+	for _, bad := range [][]string{
+		{"--protocol", "bogus"},
+		{"--expedited", "--ensemble", "3"},
+		{"--ensemble", "3", "--ensemble-threshold", "2"},
+	} {
+		if code := Run(append(append([]string{}, base...), bad...)); code == 0 {
+			t.Fatalf("expected %v to be refused", bad)
+		}
+	}
+	d, err := store.New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids, err := d.CaseIDs(); err != nil || len(ids) != 0 {
+		t.Fatalf("expected no case filed by rejected flags, got %v (%v)", ids, err)
+	}
+	if code := Run(base); code != 0 {
+		t.Fatalf("deliberate after rejected flags exited %d", code)
+	}
+	if c, err := d.LoadCase("senate-002"); err != nil || c.Status != core.StatusDecided {
+		t.Fatalf("expected the case to be decided, got %+v (%v)", c, err)
+	}
+}
+
+func TestCaseLifecycleIsTrackedAcrossCommands(t *testing.T) {
+	dir := t.TempDir()
+	if code := Run([]string{"senate", "deliberate", "--quick", "Should we rotate the keys?", "--no-handoff", "--state-dir", dir}); code != 0 {
+		t.Fatalf("deliberate exited %d", code)
+	}
+	d, err := store.New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := d.CaseIDs()
+	if err != nil || len(ids) != 1 {
+		t.Fatalf("expected one case, got %v (%v)", ids, err)
+	}
+	c, err := d.LoadCase(ids[0])
+	if err != nil || c.Status != core.StatusDecided || len(c.History) != 3 {
+		t.Fatalf("expected filed, deliberating, decided history, got %+v (%v)", c, err)
+	}
+
+	transition := []string{"senate", "case", "transition", "--case-id", ids[0], "--actor", "centurion", "--state-dir", dir, "--to"}
+	if code := Run(append(transition, "withdrawn")); code == 0 {
+		t.Fatal("expected a decided case not to be withdrawn")
+	}
+	if code := Run(append(transition, "implemented", "--note", "merged")); code != 0 {
+		t.Fatalf("transition exited %d", code)
+	}
+	c, err = d.LoadCase(ids[0])
+	if err != nil || c.Status != core.StatusImplemented {
+		t.Fatalf("expected implemented case, got %+v (%v)", c, err)
+	}
+	if last := c.History[len(c.History)-1]; last.Actor != "centurion" || last.Note != "merged" || last.From != core.StatusDecided {
+		t.Fatalf("unexpected transition %+v", last)
+	}
+}
+
+// captureStdout returns what fn prints to standard output.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+	done := make(chan []byte)
+	go func() {
+		data, _ := io.ReadAll(r)
+		done <- data
+	}()
+	fn()
+	w.Close()
+	return string(<-done)
+}
+
+func TestCaseAndVerdictListAndShow(t *testing.T) {
+	dir := t.TempDir()
+	if code := Run([]string{"senate", "deliberate", "--quick", "Should we adopt the cache layer?", "--filed-by", "athena", "--no-handoff", "--state-dir", dir}); code != 0 {
+		t.Fatalf("deliberate exited %d", code)
+	}
+	if code := Run([]string{"senate", "file-case", "--quick", "Should we drop the legacy API?", "--filed-by", "relay", "--state-dir", dir}); code != 0 {
+		t.Fatalf("file-case exited %d", code)
+	}
+
+	var cases []caseEntry
+	out := captureStdout(t, func() { Run([]string{"senate", "case", "list", "--status", "decided", "--json", "--state-dir", dir}) })
+	if err := json.Unmarshal([]byte(out), &cases); err != nil || len(cases) != 1 || cases[0].FiledBy != "athena" || cases[0].Verdict == "" {
+		t.Fatalf("expected the decided case, got %s (%v)", out, err)
+	}
+	decided := cases[0].ID
+	out = captureStdout(t, func() {
+		Run([]string{"senate", "case", "list", "--filed-by", "relay", "--since", "2000-01-01", "--json", "--state-dir", dir})
+	})
+	if err := json.Unmarshal([]byte(out), &cases); err != nil || len(cases) != 1 || cases[0].Status != core.StatusQueued {
+		t.Fatalf("expected the queued case, got %s (%v)", out, err)
+	}
+	out = captureStdout(t, func() { Run([]string{"senate", "case", "list", "--until", "2000-01-01", "--state-dir", dir}) })
+	if strings.TrimSpace(out) != "no cases" {
+		t.Fatalf("expected no cases before 2000, got %q", out)
+	}
+
+	out = captureStdout(t, func() { Run([]string{"senate", "case", "show", decided, "--state-dir", dir}) })
+	if !strings.Contains(out, "case_status: decided") || !strings.Contains(out, "history:") {
+		t.Fatalf("unexpected case show output:\n%s", out)
+	}
+
+	var verdicts []verdictEntry
+	out = captureStdout(t, func() { Run([]string{"senate", "verdict", "list", "--json", "--state-dir", dir}) })
+	if err := json.Unmarshal([]byte(out), &verdicts); err != nil || len(verdicts) != 1 || verdicts[0].CaseID != decided {
+		t.Fatalf("expected one verdict, got %s (%v)", out, err)
+	}
+	var view verdictView
+	out = captureStdout(t, func() {
+		Run([]string{"senate", "verdict", "show", decided, "--transcript", "--json", "--state-dir", dir})
+	})
+	if err := json.Unmarshal([]byte(out), &view); err != nil || view.Transcript == nil || view.Verdict.CaseStatus != core.StatusDecided {
+		t.Fatalf("expected verdict with transcript, got %s (%v)", out, err)
+	}
+	out = captureStdout(t, func() { Run([]string{"senate", "verdict", "show", decided, "--transcript", "--state-dir", dir}) })
+	if !strings.Contains(out, "verdict: ") || !strings.Contains(out, "final ") {
+		t.Fatalf("unexpected verdict show output:\n%s", out)
+	}
+	if code := Run([]string{"senate", "verdict", "show", "senate-missing", "--state-dir", dir}); code == 0 {
+		t.Fatal("expected show of a missing verdict to fail")
+	}
+}
+
+func TestMigrateRewritesLegacyDocuments(t *testing.T) {
+	dir := t.TempDir()
+	if code := Run([]string{"senate", "deliberate", "--quick", "Should we keep the legacy cache?", "--no-handoff", "--state-dir", dir}); code != 0 {
+		t.Fatalf("deliberate exited %d", code)
+	}
+	d, err := store.New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := d.CaseIDs()
+	if err != nil || len(ids) != 1 {
+		t.Fatalf("expected one case, got %v (%v)", ids, err)
+	}
+	// Strip the version and lifecycle fields, as a case stored before them.
+	path := d.CasePath(ids[0])
+	var doc map[string]any
+	data, err := os.ReadFile(path)
+	if err != nil || json.Unmarshal(data, &doc) != nil {
+		t.Fatalf("read case: %v", err)
+	}
+	delete(doc, "schema_version")
+	delete(doc, "status")
+	delete(doc, "history")
+	legacy, _ := json.Marshal(doc)
+	if err := os.WriteFile(path, legacy, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// Drop the ledger too, as state kept before it.
+	if err := os.Remove(d.LedgerPath()); err != nil {
+		t.Fatal(err)
+	}
+
+	var report schemaReport
+	out := captureStdout(t, func() { Run([]string{"senate", "migrate", "--dry-run", "--json", "--state-dir", dir}) })
+	if err := json.Unmarshal([]byte(out), &report); err != nil || report.Cases.Outdated != 1 || report.Cases.Rewritten != 0 || report.StatusInferred != 1 || report.LedgerAdopted != 3 {
+		t.Fatalf("unexpected dry-run report %s (%v)", out, err)
+	}
+	if after, _ := os.ReadFile(path); string(after) != string(legacy) {
+		t.Fatal("expected dry run to leave the case untouched")
+	}
+
+	if code := Run([]string{"senate", "migrate", "--state-dir", dir}); code != 0 {
+		t.Fatalf("migrate exited %d", code)
+	}
+	c, err := d.LoadCase(ids[0])
+	if err != nil || c.SchemaVersion != core.SchemaVersion || c.Status != core.StatusDecided {
+		t.Fatalf("expected migrated case at the current version, got %+v (%v)", c, err)
+	}
+	if code := Run([]string{"senate", "audit", "verify", "--state-dir", dir}); code != 0 {
+		t.Fatalf("expected adopted documents to pass the audit, exited %d", code)
+	}
+}
+
+func TestAuditVerifyDetectsHandEditedState(t *testing.T) {
+	dir := t.TempDir()
+	if code := Run([]string{"senate", "deliberate", "--quick", "Should we adopt the cache layer?", "--no-handoff", "--state-dir", dir}); code != 0 {
+		t.Fatalf("deliberate exited %d", code)
+	}
+	if code := Run([]string{"senate", "audit", "verify", "--state-dir", dir}); code != 0 {
+		t.Fatalf("expected a clean audit, exited %d", code)
+	}
+
+	d := &store.Dir{Root: dir}
+	ids, err := d.VerdictIDs()
+	if err != nil || len(ids) != 1 {
+		t.Fatalf("expected one verdict, got %v (%v)", ids, err)
+	}
+	v, err := d.LoadVerdict(ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	v.Reasoning = "rewritten after the fact"
+	if err := d.ReplaceVerdict(v); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(d.PrecedentIndexPath()); err != nil {
+		t.Fatal(err)
+	}
+
+	var r ledger.Report
+	out := captureStdout(t, func() {
+		if code := Run([]string{"senate", "audit", "verify", "--json", "--state-dir", dir}); code != 1 {
+			t.Errorf("expected audit to fail, exited %d", code)
+		}
+	})
+	if err := json.Unmarshal([]byte(out), &r); err != nil {
+		t.Fatalf("decode report %s: %v", out, err)
+	}
+	issues := map[string]string{}
+	for _, p := range r.Problems {
+		issues[p.Kind] = p.Issue
+	}
+	if issues["verdict"] != "altered" || issues["precedent"] != "deleted" || issues["transcript"] != "" {
+		t.Fatalf("unexpected problems %+v", r.Problems)
+	}
+}
+
+func TestMigrateAdoptsForgedDocumentsOnlyWhenAsked(t *testing.T) {
+	dir := t.TempDir()
+	if code := Run([]string{"senate", "deliberate", "--quick", "Should we adopt the cache layer?", "--no-handoff", "--state-dir", dir}); code != 0 {
+		t.Fatalf("deliberate exited %d", code)
+	}
+	d := &store.Dir{Root: dir}
+	ids, err := d.VerdictIDs()
+	if err != nil || len(ids) != 1 {
+		t.Fatalf("expected one verdict, got %v (%v)", ids, err)
+	}
+	forged, err := d.LoadVerdict(ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged.CaseID = "senate-forged-1"
+	forged.Signature = nil
+	if err := d.SaveVerdict(forged); err != nil {
+		t.Fatal(err)
+	}
+
+	if code := Run([]string{"senate", "migrate", "--state-dir", dir}); code != 0 {
+		t.Fatalf("migrate exited %d", code)
+	}
+	if code := Run([]string{"senate", "audit", "verify", "--state-dir", dir}); code != 1 {
+		t.Fatalf("expected migrate to leave the forged verdict unrecorded, audit exited %d", code)
+	}
+
+	if code := Run([]string{"senate", "migrate", "--adopt-unrecorded", "--state-dir", dir}); code != 0 {
+		t.Fatalf("migrate --adopt-unrecorded exited %d", code)
+	}
+	var r ledger.Report
+	out := captureStdout(t, func() {
+		if code := Run([]string{"senate", "audit", "verify", "--json", "--state-dir", dir}); code != 0 {
+			t.Errorf("expected the adopted verdict to pass, exited %d", code)
+		}
+	})
+	if err := json.Unmarshal([]byte(out), &r); err != nil {
+		t.Fatalf("decode report %s: %v", out, err)
+	}
+	if len(r.Adopted) != 1 || r.Adopted[0].CaseID != "senate-forged-1" {
+		t.Fatalf("expected the audit to list the adopted verdict, got %+v", r.Adopted)
+	}
+}
+
+func TestSignedVerdictVerifiesAgainstRotatedKeyring(t *testing.T) {
+	dir := t.TempDir()
+	if code := Run([]string{"senate", "keys", "init", "--state-dir", dir}); code != 0 {
+		t.Fatalf("keys init exited %d", code)
+	}
+	if code := Run([]string{"senate", "deliberate", "--quick", "Should we adopt the cache layer?", "--no-handoff", "--state-dir", dir}); code != 0 {
+		t.Fatalf("deliberate exited %d", code)
+	}
+	d := &store.Dir{Root: dir}
+	ids, err := d.VerdictIDs()
+	if err != nil || len(ids) != 1 {
+		t.Fatalf("expected one verdict, got %v (%v)", ids, err)
+	}
+	path := d.VerdictPath(ids[0])
+
+	if code := Run([]string{"senate", "keys", "rotate", "--state-dir", dir}); code != 0 {
+		t.Fatalf("keys rotate exited %d", code)
+	}
+	keyring := filepath.Join(t.TempDir(), "keyring.json")
+	if code := Run([]string{"senate", "keys", "export", "--out", keyring, "--state-dir", dir}); code != 0 {
+		t.Fatalf("keys export exited %d", code)
+	}
+	// A consumer holding only the verdict file and the exported keyring.
+	if code := Run([]string{"senate", "verify", path, "--keys", keyring}); code != 0 {
+		t.Fatalf("expected the verdict signed before rotation to verify, exited %d", code)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged := strings.Replace(string(data), `"binding": true`, `"binding": false`, 1)
+	if forged == string(data) {
+		forged = strings.Replace(string(data), `"binding": false`, `"binding": true`, 1)
+	}
+	if err := os.WriteFile(path, []byte(forged), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var r verifyResult
+	out := captureStdout(t, func() {
+		if code := Run([]string{"senate", "verify", ids[0], "--json", "--state-dir", dir}); code != 1 {
+			t.Errorf("expected the edited verdict to fail verification, exited %d", code)
+		}
+	})
+	if err := json.Unmarshal([]byte(out), &r); err != nil || r.Valid || r.Error == "" {
+		t.Fatalf("unexpected verify result %s (%v)", out, err)
+	}
+}
+
+func TestArchivedTranscriptsStayReadable(t *testing.T) {
+	dir := t.TempDir()
+	if code := Run([]string{"senate", "deliberate", "--quick", "Should we adopt the cache layer?", "--no-handoff", "--state-dir", dir}); code != 0 {
+		t.Fatalf("deliberate exited %d", code)
+	}
+	var r archiveReport
+	out := captureStdout(t, func() { Run([]string{"senate", "archive", "--json", "--state-dir", dir}) })
+	if err := json.Unmarshal([]byte(out), &r); err != nil || r.Hot != 1 || r.Archived != 0 {
+		t.Fatalf("expected a fresh transcript to stay hot, got %s (%v)", out, err)
+	}
+	if code := Run([]string{"senate", "archive", "--older-than", "soon", "--state-dir", dir}); code == 0 {
+		t.Fatal("expected a malformed --older-than to be rejected")
+	}
+	// Backdate the transcript past the retention window.
+	d := &store.Dir{Root: dir}
+	ids, err := d.TranscriptIDs()
+	if err != nil || len(ids) != 1 {
+		t.Fatalf("expected one transcript, got %v (%v)", ids, err)
+	}
+	tr, err := d.LoadTranscript(ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr.CompletedAt = "2025-01-15T10:00:00Z"
+	if err := d.SaveTranscript(tr); err != nil {
+		t.Fatal(err)
+	}
+	out = captureStdout(t, func() {
+		Run([]string{"senate", "archive", "--older-than", "30d", "--dry-run", "--json", "--state-dir", dir})
+	})
+	if err := json.Unmarshal([]byte(out), &r); err != nil || r.Archived != 1 || !r.DryRun {
+		t.Fatalf("expected a dry run to report the transcript, got %s (%v)", out, err)
+	}
+	if _, err := os.Stat(d.TranscriptPath(ids[0])); err != nil {
+		t.Fatalf("expected a dry run to leave the transcript hot: %v", err)
+	}
+	out = captureStdout(t, func() { Run([]string{"senate", "archive", "--older-than", "30d", "--json", "--state-dir", dir}) })
+	if err := json.Unmarshal([]byte(out), &r); err != nil || r.Archived != 1 || len(r.Bundles) != 1 || r.Bundles[0].Month != "2025-01" {
+		t.Fatalf("expected the transcript archived, got %s (%v)", out, err)
+	}
+	caseID := r.Bundles[0].CaseIDs[0]
+	if _, err := os.Stat(d.TranscriptPath(caseID)); !os.IsNotExist(err) {
+		t.Fatalf("expected the hot transcript removed, got %v", err)
+	}
+	if _, err := os.Stat(d.ArchivePath(r.Bundles[0].Month)); err != nil {
+		t.Fatalf("expected a bundle: %v", err)
+	}
+
+	var view verdictView
+	out = captureStdout(t, func() {
+		Run([]string{"senate", "verdict", "show", caseID, "--transcript", "--json", "--state-dir", dir})
+	})
+	if err := json.Unmarshal([]byte(out), &view); err != nil || view.Transcript == nil || view.Transcript.CompletedAt != tr.CompletedAt {
+		t.Fatalf("expected the archived transcript, got %s (%v)", out, err)
+	}
+	// Bundles stay in place when the records move to the database.
+	if code := Run([]string{"senate", "migrate", "--to", "db", "--state-dir", dir}); code != 0 {
+		t.Fatalf("migrate exited %d", code)
+	}
+	if code := Run([]string{"senate", "stats", "--case-id", caseID, "--state-dir", dir}); code != 0 {
+		t.Fatalf("expected stats to read the archived transcript, exited %d", code)
+	}
+}
+
+func TestVoteByPerspectiveFillsOnlyOneSeat(t *testing.T) {
+	dir := t.TempDir()
+	if code := Run([]string{"senate", "deliberate", "--quick", "Should we freeze the API?", "--humans", "alice,alice", "--no-handoff", "--state-dir", dir}); code != 0 {
+		t.Fatalf("deliberate exited %d", code)
+	}
+	d, err := store.New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := d.PendingIDs()
+	if err != nil || len(ids) != 1 {
+		t.Fatalf("expected one pending case, got %v (%v)", ids, err)
+	}
+	pending, err := d.LoadPending(ids[0])
+	if err != nil || len(pending.Awaiting) != 2 {
+		t.Fatalf("expected two awaited seats, got %+v (%v)", pending.Awaiting, err)
+	}
+	vote := []string{"senate", "vote", "--case-id", ids[0], "--stance", "approved", "--reasoning", "fine", "--state-dir", dir, "--seat"}
+	if code := Run(append(vote, "alice")); code == 0 {
+		t.Fatal("expected a perspective shared by two seats to be refused")
+	}
+	if code := Run(append(vote, pending.Awaiting[0])); code != 0 {
+		t.Fatalf("vote exited %d", code)
+	}
+	votes, err := d.LoadVotes(ids[0])
+	if err != nil || len(votes) != 1 || votes[0].Seat != pending.Awaiting[0] {
+		t.Fatalf("expected one vote for %s, got %+v (%v)", pending.Awaiting[0], votes, err)
+	}
+	if pending, err = d.LoadPending(ids[0]); err != nil || len(pending.Awaiting) != 1 || pending.Awaiting[0] == votes[0].Seat {
+		t.Fatalf("expected the other seat still awaited, got %+v (%v)", pending.Awaiting, err)
+	}
+	// With one seat left, the shared name identifies it.
+	if code := Run(append(vote, "alice")); code != 0 {
+		t.Fatalf("vote by the remaining seat's name exited %d", code)
+	}
+	if votes, _ = d.LoadVotes(ids[0]); len(votes) != 2 || votes[1].Seat == "alice" {
+		t.Fatalf("expected the name resolved to the seat ID, got %+v", votes)
+	}
+}
+
+func TestPendingAnonymizationStaysSealedUntilDecided(t *testing.T) {
+	dir := t.TempDir()
+	if code := Run([]string{"senate", "deliberate", "--quick", "Should we retire the v1 API?", "--anonymize", "--humans", "alice", "--no-handoff", "--state-dir", dir}); code != 0 {
+		t.Fatalf("deliberate exited %d", code)
+	}
+	d, err := store.New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, _ := d.PendingIDs()
+	if len(ids) != 1 {
+		t.Fatalf("expected one pending case, got %v", ids)
+	}
+	for _, round := range []string{core.RoundInitial, core.RoundFinal} {
+		pending, err := d.LoadPending(ids[0])
+		if err != nil || pending.Round != round {
+			t.Fatalf("expected the %s round pending, got %+v (%v)", round, pending, err)
+		}
+		if a := pending.Transcript.Anonymization; a == nil || a.Labels != nil || a.Order != nil {
+			t.Fatalf("expected only the seed stored while pending, got %+v", a)
+		}
+		if code := Run([]string{"senate", "vote", "--case-id", ids[0], "--seat", "alice", "--stance", "approved", "--reasoning", "fine", "--state-dir", dir}); code != 0 {
+			t.Fatalf("vote exited %d", code)
+		}
+	}
+	prompt, err := os.ReadFile(d.PromptPath(ids[0], "human-4", core.RoundFinal))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(prompt), "Senator ") {
+		t.Fatalf("expected the final-round prompt to label positions anonymously:\n%s", prompt)
+	}
+	transcript, err := d.LoadTranscript(ids[0])
+	if err != nil || transcript.Anonymization == nil || len(transcript.Anonymization.Labels) != 4 {
+		t.Fatalf("expected the decided transcript to record the mapping, got %+v (%v)", transcript.Anonymization, err)
+	}
+}
+
+func TestFailedHandoffHoldsVerdictForResume(t *testing.T) {
+	dir := t.TempDir()
+	bin := t.TempDir()
+	t.Setenv("PATH", bin)
+	const caseID = "senate-handoff-1"
+	c := core.Case{ID: caseID, Type: "general", Summary: "Adopt the cache layer", Question: "Should we adopt the cache layer?", Evidence: []string{"state/reports/cache-bench.md"}}
+	data, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "case.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if code := Run([]string{"senate", "deliberate", "--case", path, "--workspace", t.TempDir(), "--state-dir", dir}); code == 0 {
+		t.Fatal("expected deliberate to fail without bd")
+	}
+	d, err := store.Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pending, err := d.LoadPending(caseID)
+	if err != nil || pending.Status != core.PendingAwaitingHandoff || pending.Verdict == nil {
+		t.Fatalf("expected verdict held awaiting handoff, got %+v (%v)", pending, err)
+	}
+	if _, err := d.LoadTranscript(caseID); err == nil {
+		t.Fatal("transcript stored before the verdict was issued")
+	}
+	if _, err := d.LoadVerdict(caseID); err == nil {
+		t.Fatal("verdict stored despite the failed handoff")
+	}
+	d.Close()
+
+	script := "#!/bin/sh\necho senate-bead1\n"
+	if err := os.WriteFile(filepath.Join(bin, "bd"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if code := Run([]string{"senate", "resume", "--case-id", caseID, "--state-dir", dir}); code != 0 {
+		t.Fatalf("resume exited %d", code)
+	}
+	d, err = store.Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	v, err := d.LoadVerdict(caseID)
+	if err != nil || v.Handoff == nil || v.Handoff.BeadID != "senate-bead1" {
+		t.Fatalf("expected verdict issued with its bead, got %+v (%v)", v, err)
+	}
+	if _, err := d.LoadTranscript(caseID); err != nil {
+		t.Fatalf("transcript not stored on resume: %v", err)
+	}
+	if _, err := d.LoadPending(caseID); err == nil {
+		t.Fatal("pending record left after the verdict was issued")
+	}
+	if c, err := d.LoadCase(caseID); err != nil || c.Status != core.StatusHandedOff {
+		t.Fatalf("expected case handed off, got %s (%v)", c.Status, err)
+	}
+}
+
+func TestMissingSigningKeyFailsVerdict(t *testing.T) {
+	dir := t.TempDir()
+	if code := Run([]string{"senate", "keys", "init", "--state-dir", dir}); code != 0 {
+		t.Fatalf("keys init exited %d", code)
+	}
+	if err := os.Remove(signing.PrivateKeyPath(dir)); err != nil {
+		t.Fatal(err)
+	}
+	if code := Run([]string{"senate", "deliberate", "--quick", "Should we adopt the cache layer?", "--no-handoff", "--state-dir", dir}); code == 0 {
+		t.Fatal("expected deliberate to fail without the signing key")
+	}
+	d := &store.Dir{Root: dir}
+	if ids, err := d.VerdictIDs(); err != nil || len(ids) != 0 {
+		t.Fatalf("expected no unsigned verdict stored, got %v (%v)", ids, err)
+	}
+	ids, err := d.PendingIDs()
+	if err != nil || len(ids) != 1 {
+		t.Fatalf("expected the verdict held for resume, got %v (%v)", ids, err)
+	}
+}
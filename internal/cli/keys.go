@@ -0,0 +1,193 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/signing"
+)
+
+// signVerdict signs v with the current key of state root root before it is
+// stored. Verdicts are stored unsigned only when senate keys init was never
+// run; once it was, a missing key fails the verdict rather than dropping
+// its signature.
+func signVerdict(root string, v *core.Verdict, now time.Time) error {
+	v.Signature = nil
+	if root == "" {
+		return nil
+	}
+	signer, err := signing.LoadSigner(root)
+	if errors.Is(err, fs.ErrNotExist) && !signing.Configured(root) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("signing is set up in %s but no key can be loaded: %w", root, err)
+	}
+	return signer.Sign(v, now)
+}
+
+func cmdKeys(args []string) int {
+	if len(args) < 1 {
+		errorf("usage: senate keys init|rotate|export [flags]")
+		return 1
+	}
+	flags := parseFlags(args[1:])
+	root := resolveStateDir(flags["state-dir"])
+	jsonOut := flagBool(args, "--json")
+	now := time.Now().UTC()
+	switch args[0] {
+	case "init":
+		k, err := signing.Init(root, now)
+		if err != nil {
+			errorf("keys init: %v", err)
+			return 1
+		}
+		return printKey(jsonOut, k, root)
+	case "rotate":
+		retired, k, err := signing.Rotate(root, now)
+		if errors.Is(err, fs.ErrNotExist) {
+			errorf("keys rotate: no keyring in %s (run senate keys init)", root)
+			return 1
+		}
+		if err != nil {
+			errorf("keys rotate: %v", err)
+			return 1
+		}
+		if !jsonOut && retired.ID != "" {
+			fmt.Printf("retired: %s\n", retired.ID)
+		}
+		return printKey(jsonOut, k, root)
+	case "export":
+		ring, err := signing.LoadKeyring(signing.KeyringPath(root))
+		if err != nil {
+			errorf("keys export: %v", err)
+			return 1
+		}
+		if path := strings.TrimSpace(flags["out"]); path != "" {
+			if err := signing.WriteKeyring(path, ring); err != nil {
+				errorf("keys export: %v", err)
+				return 1
+			}
+			fmt.Printf("exported %d keys to %s\n", len(ring.Keys), path)
+			return 0
+		}
+		out, _ := json.MarshalIndent(ring, "", "  ")
+		fmt.Println(string(out))
+		return 0
+	default:
+		errorf("unknown keys subcommand: %s", args[0])
+		return 1
+	}
+}
+
+func printKey(jsonOut bool, k signing.Key, root string) int {
+	if jsonOut {
+		outputJSON(k)
+		return 0
+	}
+	fmt.Printf("created: %s\n", k.ID)
+	fmt.Printf("public_key: %s\n", k.PublicKey)
+	fmt.Printf("keyring: %s\n", signing.KeyringPath(root))
+	return 0
+}
+
+// verifyResult is the outcome of senate verify.
+type verifyResult struct {
+	CaseID   string `json:"case_id"`
+	Valid    bool   `json:"valid"`
+	KeyID    string `json:"key_id,omitempty"`
+	SignedAt string `json:"signed_at,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// cmdVerify checks a verdict's signature against a keyring: an exported
+// one given with --keys, or the state root's. The verdict is a file, such
+// as a stored verdict or senate deliberate --json output, or a stored
+// case ID. It exits 1 unless the signature is valid.
+func cmdVerify(args []string) int {
+	flags := parseFlags(args)
+	ref := idArg(args, flags)
+	if ref == "" {
+		errorf("usage: senate verify <verdict.json|case-id> [--keys <keyring.json>] [--json]")
+		return 1
+	}
+	v, data, err := loadVerdictRef(ref, flags)
+	if err != nil {
+		errorf("load verdict: %v", err)
+		return 1
+	}
+	path := strings.TrimSpace(flags["keys"])
+	if path == "" {
+		path = signing.KeyringPath(resolveStateDir(flags["state-dir"]))
+	}
+	ring, err := signing.LoadKeyring(path)
+	if err != nil {
+		errorf("load keyring: %v", err)
+		return 1
+	}
+
+	r := verifyResult{CaseID: v.CaseID}
+	verify := func() (signing.Key, error) { return signing.Verify(v, ring) }
+	if data != nil {
+		verify = func() (signing.Key, error) { return signing.VerifyDocument(data, ring) }
+	}
+	if key, err := verify(); err != nil {
+		r.Error = err.Error()
+	} else {
+		r.Valid, r.KeyID, r.SignedAt = true, key.ID, v.Signature.SignedAt
+	}
+	code := 0
+	if !r.Valid {
+		code = 1
+	}
+	if flagBool(args, "--json") {
+		outputJSON(r)
+		return code
+	}
+	fmt.Printf("case_id: %s\n", r.CaseID)
+	if !r.Valid {
+		fmt.Printf("invalid: %s\n", r.Error)
+		return code
+	}
+	fmt.Printf("valid: signed by key %s at %s\n", r.KeyID, r.SignedAt)
+	return 0
+}
+
+// loadVerdictRef reads a verdict from a file, returning the file's bytes
+// too so the document itself is verified, or from the store when ref is
+// not a file.
+func loadVerdictRef(ref string, flags map[string]string) (core.Verdict, []byte, error) {
+	var v core.Verdict
+	if data, err := os.ReadFile(ref); err == nil {
+		if err := core.Decode(core.DocVerdict, data, &v); err != nil {
+			return v, nil, err
+		}
+		// senate deliberate --json adds the case status to the verdict.
+		var doc map[string]json.RawMessage
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return v, nil, err
+		}
+		if _, ok := doc["case_status"]; ok {
+			delete(doc, "case_status")
+			if data, err = json.Marshal(doc); err != nil {
+				return v, nil, err
+			}
+		}
+		return v, data, nil
+	} else if !os.IsNotExist(err) {
+		return v, nil, err
+	}
+	d, err := openStore(flags)
+	if err != nil {
+		return v, nil, err
+	}
+	defer d.Close()
+	v, err = d.LoadVerdict(ref)
+	return v, nil, err
+}
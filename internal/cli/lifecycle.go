@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/deliberation"
+	"github.com/Perttulands/senate/internal/store"
+)
+
+// senateActor is the actor recorded for transitions Senate makes itself.
+const senateActor = "senate"
+
+// verdictOutput is a verdict as printed by --json, with the case status.
+type verdictOutput struct {
+	core.Verdict
+	CaseStatus core.CaseStatus `json:"case_status,omitempty"`
+}
+
+// pendingOutput is a paused deliberation as printed by --json, with the
+// case status.
+type pendingOutput struct {
+	core.PendingDeliberation
+	CaseStatus core.CaseStatus `json:"case_status,omitempty"`
+}
+
+// lifecycleStore is what case status tracking reads and writes: the case,
+// and the verdict and pending records a status is inferred from.
+type lifecycleStore interface {
+	store.CaseStore
+	store.VerdictStore
+	store.PendingStore
+}
+
+// advanceCase moves a stored case to status to and returns the status it
+// ends in; a case already there is left alone. Withdrawing or superseding a
+// case also drops any paused deliberation, so it cannot be resumed.
+func advanceCase(d lifecycleStore, caseID string, to core.CaseStatus, actor, note string, now time.Time) (core.CaseStatus, error) {
+	c, err := d.LoadCase(caseID)
+	if err != nil {
+		return "", err
+	}
+	if c.Status == "" {
+		inferStatus(d, &c, now)
+	}
+	if c.Status == to {
+		return to, nil
+	}
+	if err := c.Advance(to, now, actor, note); err != nil {
+		return c.Status, err
+	}
+	if err := d.ReplaceCase(c); err != nil {
+		return "", err
+	}
+	if to == core.StatusWithdrawn || to == core.StatusSuperseded {
+		if err := d.DeletePending(caseID); err != nil {
+			return to, err
+		}
+	}
+	return to, nil
+}
+
+// inferStatus gives a case stored before lifecycle tracking the status its
+// records imply, recorded as a single transition by senate.
+func inferStatus(d lifecycleStore, c *core.Case, now time.Time) {
+	status := core.StatusFiled
+	if v, err := d.LoadVerdict(c.ID); err == nil {
+		status = core.StatusDecided
+		if hasHandoff(v) {
+			status = core.StatusHandedOff
+		}
+	} else if p, err := d.LoadPending(c.ID); err == nil {
+		status = core.StatusDeliberating
+		if p.Status == core.PendingAwaitingEvidence {
+			status = core.StatusAwaitingEvidence
+		}
+	}
+	c.Status = status
+	c.History = append(c.History, core.Transition{To: status, At: now.UTC().Format(time.RFC3339), Actor: senateActor, Note: "inferred from stored records"})
+}
+
+// caseStatus reports a stored case's lifecycle status, or "" when the case
+// cannot be loaded.
+func caseStatus(d lifecycleStore, caseID string) core.CaseStatus {
+	c, err := d.LoadCase(caseID)
+	if err != nil {
+		return ""
+	}
+	if c.Status == "" {
+		inferStatus(d, &c, time.Now())
+	}
+	return c.Status
+}
+
+// hasHandoff reports whether a bead was created for any part of a verdict.
+func hasHandoff(v core.Verdict) bool {
+	if v.Handoff != nil && v.Handoff.BeadID != "" {
+		return true
+	}
+	for _, it := range v.Items {
+		if it.Handoff != nil && it.Handoff.BeadID != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// cmdCaseTransition records a lifecycle change made outside Senate, such as
+// a case being withdrawn or its decision implemented.
+func cmdCaseTransition(args []string) int {
+	flags := parseFlags(args)
+	caseID := strings.TrimSpace(flags["case-id"])
+	actor := strings.TrimSpace(flags["actor"])
+	if caseID == "" || actor == "" || strings.TrimSpace(flags["to"]) == "" {
+		errorf("usage: senate case transition --case-id <id> --to <status> --actor <name> [--note <text>]")
+		return 1
+	}
+	to, err := core.ParseCaseStatus(flags["to"])
+	if err != nil {
+		errorf("%v", err)
+		return 1
+	}
+	d, err := openStore(flags)
+	if err != nil {
+		errorf("open store: %v", err)
+		return 1
+	}
+	defer d.Close()
+
+	var c core.Case
+	err = d.Update(func(tx store.Store) error {
+		if _, err := advanceCase(tx, caseID, to, actor, strings.TrimSpace(flags["note"]), time.Now().UTC()); err != nil {
+			return err
+		}
+		c, err = tx.LoadCase(caseID)
+		return err
+	})
+	if err != nil {
+		errorf("transition: %v", err)
+		return 1
+	}
+	if flagBool(args, "--json") {
+		outputJSON(c)
+		return 0
+	}
+	fmt.Printf("case_id: %s\n", c.ID)
+	fmt.Printf("case_status: %s\n", c.Status)
+	printHistory(c.History)
+	return 0
+}
+
+func printHistory(history []core.Transition) {
+	for _, t := range history {
+		line := fmt.Sprintf("history: %s %s by %s", t.At, t.To, t.Actor)
+		if t.Note != "" {
+			line += " (" + t.Note + ")"
+		}
+		fmt.Println(line)
+	}
+}
+
+// withdrawFailed withdraws a freshly filed case whose deliberation failed
+// outright, so it is not left deliberating with nothing to resume. Paused
+// deliberations are left alone.
+func withdrawFailed(d lifecycleStore, caseID string, err error, now time.Time) {
+	var awaiting *deliberation.AwaitingVotesError
+	var motion *deliberation.MotionError
+	if err == nil || errors.As(err, &awaiting) || errors.As(err, &motion) {
+		return
+	}
+	if _, wErr := advanceCase(d, caseID, core.StatusWithdrawn, senateActor, "deliberation failed: "+err.Error(), now); wErr != nil {
+		errorf("withdraw case: %v", wErr)
+	}
+}
@@ -0,0 +1,300 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/ledger"
+	"github.com/Perttulands/senate/internal/store"
+)
+
+// cmdMigrate moves a state root between the directory layout and the
+// embedded database backend, or, without --to, rewrites its documents to
+// the current schema version.
+func cmdMigrate(args []string) int {
+	flags := parseFlags(args)
+	root := resolveStateDir(flags["state-dir"])
+	to := strings.TrimSpace(flags["to"])
+	if to == "" {
+		return migrateSchema(flags, flagBool(args, "--dry-run"), flagBool(args, "--adopt-unrecorded"), flagBool(args, "--json"))
+	}
+	if flagBool(args, "--dry-run") || flagBool(args, "--adopt-unrecorded") {
+		errorf("--dry-run and --adopt-unrecorded apply to schema migration (senate migrate without --to)")
+		return 1
+	}
+	var n store.Counts
+	var err error
+	switch to {
+	case "db":
+		n, err = store.MigrateToDB(root)
+	case "dir":
+		n, err = store.MigrateToDir(root)
+	default:
+		errorf("usage: senate migrate [--to db|dir] [--dry-run] [--state-dir <path>]")
+		return 1
+	}
+	if err != nil {
+		errorf("migrate: %v", err)
+		return 1
+	}
+	if flagBool(args, "--json") {
+		outputJSON(n)
+		return 0
+	}
+	fmt.Printf("migrated %s to %s\n", root, flags["to"])
+	fmt.Printf("cases: %d transcripts: %d verdicts: %d pending: %d votes: %d precedents: %d outbox: %d ledger: %d\n",
+		n.Cases, n.Transcripts, n.Verdicts, n.Pending, n.Votes, n.Precedents, n.Outbox, n.Ledger)
+	if n.PrecedentsSkipped > 0 {
+		fmt.Printf("skipped %d malformed precedent line(s), not migrated\n", n.PrecedentsSkipped)
+	}
+	return 0
+}
+
+// schemaCount tallies one document kind during a schema migration.
+type schemaCount struct {
+	Scanned   int `json:"scanned"`
+	Outdated  int `json:"outdated"`
+	Rewritten int `json:"rewritten"`
+}
+
+func (c *schemaCount) add(version int) bool {
+	c.Scanned++
+	if version >= core.SchemaVersion {
+		return false
+	}
+	c.Outdated++
+	return true
+}
+
+// schemaReport is the result of senate migrate without --to. Votes and
+// precedent records are append-only logs, and archived transcripts sit in
+// compressed bundles: outdated ones are upgraded each time they are read
+// and never rewritten.
+type schemaReport struct {
+	SchemaVersion  int         `json:"schema_version"`
+	DryRun         bool        `json:"dry_run"`
+	Cases          schemaCount `json:"cases"`
+	Transcripts    schemaCount `json:"transcripts"`
+	Verdicts       schemaCount `json:"verdicts"`
+	Pending        schemaCount `json:"pending"`
+	Votes          schemaCount `json:"votes"`
+	Precedents     schemaCount `json:"precedents"`
+	StatusInferred int         `json:"status_inferred"`
+	LedgerAdopted  int         `json:"ledger_adopted"`
+	// LedgerUnrecorded counts documents the ledger has no entry for that
+	// were left unadopted.
+	LedgerUnrecorded int `json:"ledger_unrecorded"`
+}
+
+// migrateSchema rewrites every stored case, transcript, verdict and paused
+// deliberation below the current schema version, and records an inferred
+// lifecycle status on cases stored before status tracking. Verdicts,
+// transcripts and precedent records stored before the audit ledger are
+// adopted into it when the ledger is empty, or with adopt on a ledger that
+// already has entries; documents altered since they were recorded never
+// are. All rewrites commit together; a dry run only reports them.
+func migrateSchema(flags map[string]string, dryRun, adopt, jsonOut bool) int {
+	d, err := openStore(flags)
+	if err != nil {
+		errorf("open store: %v", err)
+		return 1
+	}
+	defer d.Close()
+
+	r := schemaReport{SchemaVersion: core.SchemaVersion, DryRun: dryRun}
+	now := time.Now().UTC()
+	run := d.Update
+	if dryRun {
+		run = d.View
+	}
+	err = run(func(tx store.Store) error {
+		ids, err := tx.CaseIDs()
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			c, err := tx.LoadCase(id)
+			if err != nil {
+				return err
+			}
+			outdated := r.Cases.add(c.SchemaVersion)
+			if c.Status == "" {
+				inferStatus(tx, &c, now)
+				r.StatusInferred++
+				outdated = true
+			}
+			if outdated && !dryRun {
+				if err := tx.ReplaceCase(c); err != nil {
+					return fmt.Errorf("case %s: %w", id, err)
+				}
+				r.Cases.Rewritten++
+			}
+			votes, err := tx.LoadVotes(id)
+			if err != nil {
+				return err
+			}
+			for _, v := range votes {
+				r.Votes.add(v.SchemaVersion)
+			}
+		}
+		if ids, err = tx.TranscriptIDs(); err != nil {
+			return err
+		}
+		archived, err := tx.ArchivedTranscriptIDs()
+		if err != nil {
+			return err
+		}
+		cold := map[string]bool{}
+		for _, id := range archived {
+			cold[id] = true
+		}
+		for _, id := range ids {
+			t, err := tx.LoadTranscript(id)
+			if err != nil {
+				return err
+			}
+			if r.Transcripts.add(t.SchemaVersion) && !dryRun && !cold[id] {
+				if err := tx.SaveTranscript(t); err != nil {
+					return fmt.Errorf("transcript %s: %w", id, err)
+				}
+				r.Transcripts.Rewritten++
+			}
+		}
+		if ids, err = tx.VerdictIDs(); err != nil {
+			return err
+		}
+		for _, id := range ids {
+			v, err := tx.LoadVerdict(id)
+			if err != nil {
+				return err
+			}
+			if r.Verdicts.add(v.SchemaVersion) && !dryRun {
+				if err := tx.ReplaceVerdict(v); err != nil {
+					return fmt.Errorf("verdict %s: %w", id, err)
+				}
+				r.Verdicts.Rewritten++
+			}
+		}
+		if ids, err = tx.PendingIDs(); err != nil {
+			return err
+		}
+		for _, id := range ids {
+			p, err := tx.LoadPending(id)
+			if err != nil {
+				return err
+			}
+			if r.Pending.add(p.SchemaVersion) && !dryRun {
+				if err := tx.SavePending(p); err != nil {
+					return fmt.Errorf("pending %s: %w", id, err)
+				}
+				r.Pending.Rewritten++
+			}
+		}
+		records, err := tx.LoadPrecedents()
+		if err != nil {
+			return err
+		}
+		for _, rec := range records {
+			r.Precedents.add(rec.SchemaVersion)
+		}
+		return adoptUnrecorded(tx, &r, adopt, dryRun, now)
+	})
+	if err != nil {
+		errorf("migrate: %v", err)
+		return 1
+	}
+
+	if jsonOut {
+		outputJSON(r)
+		return 0
+	}
+	if dryRun {
+		fmt.Printf("dry run: schema_version %d, nothing written\n", r.SchemaVersion)
+	} else {
+		fmt.Printf("migrated to schema_version %d\n", r.SchemaVersion)
+	}
+	for _, row := range []struct {
+		name string
+		n    schemaCount
+	}{{"cases", r.Cases}, {"transcripts", r.Transcripts}, {"verdicts", r.Verdicts}, {"pending", r.Pending}} {
+		fmt.Printf("%s: %d scanned, %d outdated, %d rewritten\n", row.name, row.n.Scanned, row.n.Outdated, row.n.Rewritten)
+	}
+	fmt.Printf("votes: %d scanned, %d outdated (append-only, upgraded on read)\n", r.Votes.Scanned, r.Votes.Outdated)
+	fmt.Printf("precedents: %d scanned, %d outdated (append-only, upgraded on read)\n", r.Precedents.Scanned, r.Precedents.Outdated)
+	fmt.Printf("status_inferred: %d\n", r.StatusInferred)
+	fmt.Printf("ledger_adopted: %d\n", r.LedgerAdopted)
+	if r.LedgerUnrecorded > 0 {
+		fmt.Printf("ledger_unrecorded: %d (not adopted; check them, then pass --adopt-unrecorded)\n", r.LedgerUnrecorded)
+	}
+	return 0
+}
+
+// adoptUnrecorded records in the audit ledger every verdict, transcript and
+// precedent record it has no entry for. Adopting vouches for whatever is on
+// disk, so it runs unasked only to bootstrap an empty ledger; once the
+// ledger has entries, an unrecorded document may be forged and is adopted
+// only with adopt.
+func adoptUnrecorded(tx store.Store, r *schemaReport, adopt, dryRun bool, now time.Time) error {
+	entries, err := tx.LoadLedger()
+	if err != nil {
+		return err
+	}
+	audit, err := ledger.Verify(entries, tx)
+	if err != nil {
+		return err
+	}
+	adopt = adopt || len(entries) == 0
+	precedents := map[string]int{}
+	for _, p := range audit.Problems {
+		if p.Issue != ledger.IssueUnrecorded {
+			continue
+		}
+		if !adopt {
+			r.LedgerUnrecorded++
+			continue
+		}
+		r.LedgerAdopted++
+		if dryRun {
+			continue
+		}
+		var doc any
+		switch p.Kind {
+		case ledger.KindVerdict:
+			doc, err = tx.LoadVerdict(p.CaseID)
+		case ledger.KindTranscript:
+			doc, err = tx.LoadTranscript(p.CaseID)
+		case ledger.KindPrecedent:
+			precedents[p.DocHash]++
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := recordLedger(tx, p.Kind, p.CaseID, doc, reasonAdopted, now); err != nil {
+			return fmt.Errorf("adopt %s %s: %w", p.Kind, p.CaseID, err)
+		}
+	}
+	if len(precedents) == 0 {
+		return nil
+	}
+	records, err := tx.LoadPrecedents()
+	if err != nil {
+		return err
+	}
+	for _, rec := range records {
+		h, err := ledger.Hash(rec)
+		if err != nil {
+			return err
+		}
+		if precedents[h] == 0 {
+			continue
+		}
+		precedents[h]--
+		if err := recordLedger(tx, ledger.KindPrecedent, rec.CaseID, rec, reasonAdopted, now); err != nil {
+			return fmt.Errorf("adopt precedent %s: %w", rec.CaseID, err)
+		}
+	}
+	return nil
+}
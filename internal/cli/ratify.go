@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/deliberation"
+	"github.com/Perttulands/senate/internal/handoff"
+	"github.com/Perttulands/senate/internal/store"
+)
+
+// ratificationPending is the pending record scheduling the full panel that
+// must ratify an expedited verdict.
+func ratificationPending(caseID string, panel []deliberation.Perspective, judge string, opts map[string]string, now time.Time) core.PendingDeliberation {
+	ratify := map[string]string{"ratification": "true"}
+	for k, v := range opts {
+		ratify[k] = v
+	}
+	return core.PendingDeliberation{
+		CaseID:     caseID,
+		Status:     core.PendingAwaitingRatification,
+		OpenedAt:   now.Format(time.RFC3339),
+		OnTimeout:  core.DecisionDefer,
+		Options:    ratify,
+		Transcript: core.Transcript{CaseID: caseID, Panel: deliberation.PanelMembers(panel), JudgeModel: judge},
+	}
+}
+
+// ratificationStore is what scheduleRatification writes to.
+type ratificationStore interface {
+	store.PendingStore
+	store.OutboxStore
+}
+
+// scheduleRatification saves the pending ratification and queues it in the
+// outbox. It runs in the transaction that stores the provisional verdict, so
+// a provisional verdict is never left without its ratification.
+func scheduleRatification(tx ratificationStore, pending core.PendingDeliberation, now time.Time) error {
+	if err := tx.SavePending(pending); err != nil {
+		return fmt.Errorf("schedule ratification: %w", err)
+	}
+	envelope := map[string]any{
+		"type":      "senate.ratification.scheduled",
+		"case_id":   pending.CaseID,
+		"panel":     pending.Transcript.Panel,
+		"queued_at": now.Format(time.RFC3339),
+	}
+	if err := tx.AppendOutbox(store.OutboxRatificationScheduled, envelope); err != nil {
+		return fmt.Errorf("queue ratification: %w", err)
+	}
+	return nil
+}
+
+// ratifyVerdict settles a provisional verdict with the panel's verdict. A
+// confirmed verdict keeps the provisional handoff beads; an overturned one
+// gets its own and lists the provisional ones, which are closed.
+func ratifyVerdict(provisional, panel core.Verdict, now time.Time) core.Verdict {
+	v := deliberation.Ratify(provisional, panel, now)
+	if v.Ratification.Status != core.RatificationConfirmed {
+		v.Ratification.ProvisionalBeads = beadIDs(provisional)
+		return v
+	}
+	v.Handoff = provisional.Handoff
+	for i := range v.Items {
+		v.Items[i].Handoff = provisional.Items[i].Handoff
+	}
+	return v
+}
+
+// beadIDs lists the beads a verdict and its items were handed off to.
+func beadIDs(v core.Verdict) []string {
+	var ids []string
+	if v.Handoff != nil && v.Handoff.BeadID != "" {
+		ids = append(ids, v.Handoff.BeadID)
+	}
+	for _, it := range v.Items {
+		if it.Handoff != nil && it.Handoff.BeadID != "" {
+			ids = append(ids, it.Handoff.BeadID)
+		}
+	}
+	return ids
+}
+
+// closeProvisionalBeads closes the beads of an overturned provisional
+// verdict. A bead that cannot be closed is reported and left for a human;
+// the verdict still lists it under ratification.provisional_beads.
+func closeProvisionalBeads(ctx context.Context, workspace string, v core.Verdict) {
+	r := v.Ratification
+	if r == nil || r.Status != core.RatificationOverturned {
+		return
+	}
+	reason := fmt.Sprintf("Senate %s: provisional %s verdict overturned on ratification", v.CaseID, r.ProvisionalVerdict)
+	for _, id := range r.ProvisionalBeads {
+		if err := handoff.CloseBead(ctx, nil, workspace, id, reason); err != nil {
+			errorf("handoff: %v", err)
+		}
+	}
+}
+
+// cmdRatify runs the full-panel ratification of one expedited verdict, or
+// of every scheduled one when no case is given.
+func cmdRatify(args []string) int {
+	flags := parseFlags(args)
+	d, err := openStore(flags)
+	if err != nil {
+		errorf("open store: %v", err)
+		return 1
+	}
+	defer d.Close()
+	ids := []string{strings.TrimSpace(flags["case-id"])}
+	if ids[0] == "" {
+		pendingIDs, err := d.PendingIDs()
+		if err != nil {
+			errorf("list pending: %v", err)
+			return 1
+		}
+		ids = ids[:0]
+		for _, id := range pendingIDs {
+			if p, err := d.LoadPending(id); err == nil && p.Status == core.PendingAwaitingRatification {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			fmt.Println("no ratifications scheduled")
+			return 0
+		}
+	} else if p, err := d.LoadPending(ids[0]); err != nil || p.Status != core.PendingAwaitingRatification {
+		errorf("case %s has no scheduled ratification", ids[0])
+		return 1
+	}
+	code := 0
+	for _, id := range ids {
+		if rc := resumeDeliberation(d, id, flagBool(args, "--json"), time.Now().UTC()); rc != 0 {
+			code = rc
+		}
+	}
+	return code
+}
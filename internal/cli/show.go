@@ -0,0 +1,435 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/store"
+)
+
+// caseEntry is one row of senate case list.
+type caseEntry struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Status     core.CaseStatus `json:"status"`
+	FiledAt    string          `json:"filed_at"`
+	FiledBy    string          `json:"filed_by,omitempty"`
+	Summary    string          `json:"summary"`
+	Verdict    core.Decision   `json:"verdict,omitempty"`
+	Pending    string          `json:"pending,omitempty"`
+	Supersedes string          `json:"supersedes,omitempty"`
+}
+
+// verdictEntry is one row of senate verdict list.
+type verdictEntry struct {
+	CaseID      string        `json:"case_id"`
+	Type        string        `json:"type"`
+	Verdict     core.Decision `json:"verdict"`
+	Outcome     string        `json:"outcome,omitempty"`
+	Binding     bool          `json:"binding"`
+	Provisional bool          `json:"provisional,omitempty"`
+	VerdictAt   string        `json:"verdict_at"`
+	Summary     string        `json:"summary"`
+}
+
+// verdictView is senate verdict show --transcript --json.
+type verdictView struct {
+	Verdict    verdictOutput    `json:"verdict"`
+	Transcript *core.Transcript `json:"transcript,omitempty"`
+}
+
+// timeRange is an inclusive --since/--until filter. Bare dates cover the
+// whole day.
+type timeRange struct {
+	since, until time.Time
+}
+
+func parseTimeRange(flags map[string]string) (timeRange, error) {
+	var r timeRange
+	var err error
+	if r.since, err = parseDateFlag("since", flags["since"], false); err != nil {
+		return r, err
+	}
+	r.until, err = parseDateFlag("until", flags["until"], true)
+	return r, err
+}
+
+func parseDateFlag(name, raw string, endOfDay bool) (time.Time, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("--%s must be YYYY-MM-DD or RFC3339", name)
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Second)
+	}
+	return t, nil
+}
+
+func (r timeRange) contains(stamp string) bool {
+	if r.since.IsZero() && r.until.IsZero() {
+		return true
+	}
+	t, err := time.Parse(time.RFC3339, stamp)
+	if err != nil {
+		return false
+	}
+	return (r.since.IsZero() || !t.Before(r.since)) && (r.until.IsZero() || !t.After(r.until))
+}
+
+// idArg returns the id given as the first argument or by --case-id.
+func idArg(args []string, flags map[string]string) string {
+	if len(args) > 0 && !strings.HasPrefix(args[0], "--") {
+		return strings.TrimSpace(args[0])
+	}
+	return strings.TrimSpace(flags["case-id"])
+}
+
+// cmdCaseList lists stored cases in ID order, filtered by type, status,
+// filer and filing date.
+func cmdCaseList(args []string) int {
+	flags := parseFlags(args)
+	window, err := parseTimeRange(flags)
+	if err != nil {
+		errorf("%v", err)
+		return 1
+	}
+	var status core.CaseStatus
+	if raw := strings.TrimSpace(flags["status"]); raw != "" {
+		if status, err = core.ParseCaseStatus(raw); err != nil {
+			errorf("%v", err)
+			return 1
+		}
+	}
+	d, err := openStore(flags)
+	if err != nil {
+		errorf("open store: %v", err)
+		return 1
+	}
+	defer d.Close()
+
+	ids, err := d.CaseIDs()
+	if err != nil {
+		errorf("list cases: %v", err)
+		return 1
+	}
+	entries := []caseEntry{}
+	for _, id := range ids {
+		c, err := d.LoadCase(id)
+		if err != nil {
+			errorf("load case: %v", err)
+			return 1
+		}
+		if c.Status == "" {
+			inferStatus(d, &c, time.Now())
+		}
+		if t := strings.TrimSpace(flags["type"]); t != "" && c.Type != t {
+			continue
+		}
+		if status != "" && c.Status != status {
+			continue
+		}
+		if f := strings.TrimSpace(flags["filed-by"]); f != "" && c.FiledBy != f {
+			continue
+		}
+		if !window.contains(c.FiledAt) {
+			continue
+		}
+		e := caseEntry{ID: c.ID, Type: c.Type, Status: c.Status, FiledAt: c.FiledAt, FiledBy: c.FiledBy, Summary: c.Summary, Supersedes: c.Supersedes}
+		if v, err := d.LoadVerdict(id); err == nil {
+			e.Verdict = v.Verdict
+		}
+		if p, err := d.LoadPending(id); err == nil {
+			e.Pending = p.Status
+		}
+		entries = append(entries, e)
+	}
+	if limit := parseInt(flags["limit"], 0); limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+
+	if flagBool(args, "--json") {
+		outputJSON(entries)
+		return 0
+	}
+	if len(entries) == 0 {
+		fmt.Println("no cases")
+		return 0
+	}
+	for _, e := range entries {
+		state := string(e.Status)
+		if e.Verdict != "" {
+			state += "/" + string(e.Verdict)
+		}
+		if e.Pending != "" {
+			state += "/" + e.Pending
+		}
+		fmt.Printf("%s %s %s %s %s\n", e.ID, state, e.Type, e.FiledAt, e.Summary)
+	}
+	return 0
+}
+
+// cmdCaseShow prints one stored case with its lifecycle history.
+func cmdCaseShow(args []string) int {
+	flags := parseFlags(args)
+	caseID := idArg(args, flags)
+	if caseID == "" {
+		errorf("usage: senate case show <id> [--json]")
+		return 1
+	}
+	d, err := openStore(flags)
+	if err != nil {
+		errorf("open store: %v", err)
+		return 1
+	}
+	defer d.Close()
+
+	c, err := d.LoadCase(caseID)
+	if err != nil {
+		errorf("load case: %v", err)
+		return 1
+	}
+	if c.Status == "" {
+		inferStatus(d, &c, time.Now())
+	}
+	if flagBool(args, "--json") {
+		outputJSON(c)
+		return 0
+	}
+	fmt.Printf("case_id: %s\n", c.ID)
+	fmt.Printf("case_status: %s\n", c.Status)
+	fmt.Printf("type: %s\n", c.Type)
+	fmt.Printf("summary: %s\n", c.Summary)
+	fmt.Printf("question: %s\n", c.Question)
+	if c.RequestedDecision != "" {
+		fmt.Printf("requested_decision: %s\n", c.RequestedDecision)
+	}
+	fmt.Printf("filed_at: %s\n", c.FiledAt)
+	if c.FiledBy != "" {
+		fmt.Printf("filed_by: %s\n", c.FiledBy)
+	}
+	if c.Supersedes != "" {
+		fmt.Printf("supersedes: %s\n", c.Supersedes)
+	}
+	for _, e := range c.Evidence {
+		fmt.Printf("evidence: %s\n", e)
+	}
+	if len(c.Options) > 0 {
+		fmt.Printf("options: %s\n", strings.Join(c.Options, ","))
+	}
+	for _, q := range c.Questions {
+		fmt.Printf("question %s: %s\n", q.ID, q.Question)
+	}
+	printHistory(c.History)
+	if p, err := d.LoadPending(caseID); err == nil {
+		fmt.Printf("pending: %s\n", p.Status)
+	}
+	if v, err := d.LoadVerdict(caseID); err == nil {
+		fmt.Printf("verdict: %s binding=%t\n", v.Verdict, v.Binding)
+		fmt.Printf("verdict_file: %s\n", d.Location(store.KindVerdict, caseID))
+	}
+	return 0
+}
+
+func cmdVerdict(args []string) int {
+	if len(args) < 1 {
+		errorf("usage: senate verdict list|show [flags]")
+		return 1
+	}
+	switch args[0] {
+	case "list":
+		return cmdVerdictList(args[1:])
+	case "show":
+		return cmdVerdictShow(args[1:])
+	default:
+		errorf("unknown verdict subcommand: %s", args[0])
+		return 1
+	}
+}
+
+// cmdVerdictList lists stored verdicts filtered by type, decision and
+// verdict date.
+func cmdVerdictList(args []string) int {
+	flags := parseFlags(args)
+	window, err := parseTimeRange(flags)
+	if err != nil {
+		errorf("%v", err)
+		return 1
+	}
+	decision := core.Decision("")
+	if raw := strings.TrimSpace(flags["verdict"]); raw != "" {
+		if decision = parseDecision(raw); decision == "" {
+			errorf("unknown verdict %q", raw)
+			return 1
+		}
+	}
+	d, err := openStore(flags)
+	if err != nil {
+		errorf("open store: %v", err)
+		return 1
+	}
+	defer d.Close()
+
+	ids, err := d.VerdictIDs()
+	if err != nil {
+		errorf("list verdicts: %v", err)
+		return 1
+	}
+	entries := []verdictEntry{}
+	for _, id := range ids {
+		v, err := d.LoadVerdict(id)
+		if err != nil {
+			errorf("load verdict: %v", err)
+			return 1
+		}
+		if t := strings.TrimSpace(flags["type"]); t != "" && v.Type != t {
+			continue
+		}
+		if decision != "" && v.Verdict != decision {
+			continue
+		}
+		if !window.contains(v.VerdictAt) {
+			continue
+		}
+		entries = append(entries, verdictEntry{CaseID: v.CaseID, Type: v.Type, Verdict: v.Verdict, Outcome: v.Outcome, Binding: v.Binding, Provisional: v.Provisional, VerdictAt: v.VerdictAt, Summary: v.Summary})
+	}
+	if limit := parseInt(flags["limit"], 0); limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+
+	if flagBool(args, "--json") {
+		outputJSON(entries)
+		return 0
+	}
+	if len(entries) == 0 {
+		fmt.Println("no verdicts")
+		return 0
+	}
+	for _, e := range entries {
+		decision := string(e.Verdict)
+		if e.Outcome != "" {
+			decision += " (" + e.Outcome + ")"
+		}
+		fmt.Printf("%s %s binding=%t %s %s\n", e.CaseID, decision, e.Binding, e.VerdictAt, e.Summary)
+	}
+	return 0
+}
+
+// cmdVerdictShow prints one stored verdict, optionally with the transcript
+// of the deliberation behind it.
+func cmdVerdictShow(args []string) int {
+	flags := parseFlags(args)
+	caseID := idArg(args, flags)
+	if caseID == "" {
+		errorf("usage: senate verdict show <id> [--transcript] [--json]")
+		return 1
+	}
+	d, err := openStore(flags)
+	if err != nil {
+		errorf("open store: %v", err)
+		return 1
+	}
+	defer d.Close()
+
+	v, err := d.LoadVerdict(caseID)
+	if err != nil {
+		errorf("load verdict: %v", err)
+		return 1
+	}
+	out := verdictOutput{v, caseStatus(d, caseID)}
+	var transcript *core.Transcript
+	if flagBool(args, "--transcript") {
+		t, err := d.LoadTranscript(caseID)
+		if err != nil {
+			errorf("load transcript: %v", err)
+			return 1
+		}
+		transcript = &t
+	}
+	if flagBool(args, "--json") {
+		if transcript == nil {
+			outputJSON(out)
+		} else {
+			outputJSON(verdictView{Verdict: out, Transcript: transcript})
+		}
+		return 0
+	}
+
+	fmt.Printf("case_id: %s\n", v.CaseID)
+	fmt.Printf("case_status: %s\n", out.CaseStatus)
+	fmt.Printf("type: %s\n", v.Type)
+	fmt.Printf("summary: %s\n", v.Summary)
+	fmt.Printf("verdict: %s\n", v.Verdict)
+	if v.Outcome != "" {
+		fmt.Printf("outcome: %s\n", v.Outcome)
+	}
+	fmt.Printf("binding: %t\n", v.Binding)
+	if v.Provisional {
+		fmt.Printf("provisional: true (judge alone, pending ratification)\n")
+	}
+	if r := v.Ratification; r != nil {
+		fmt.Printf("ratification: %s (provisional %s)\n", r.Status, r.ProvisionalVerdict)
+	}
+	fmt.Printf("verdict_at: %s\n", v.VerdictAt)
+	fmt.Printf("judge: %s\n", v.Judge)
+	if s := v.Signature; s != nil {
+		fmt.Printf("signature: key %s at %s\n", s.KeyID, s.SignedAt)
+	}
+	fmt.Printf("reasoning: %s\n", v.Reasoning)
+	fmt.Printf("implementation: %s\n", v.Implementation)
+	if v.Dissent != "" {
+		fmt.Printf("dissent: %s\n", v.Dissent)
+	}
+	for _, it := range v.Items {
+		decision := string(it.Verdict)
+		if it.Outcome != "" {
+			decision += " (" + it.Outcome + ")"
+		}
+		fmt.Printf("item %s: %s binding=%t\n", it.ItemID, decision, it.Binding)
+	}
+	if v.Handoff != nil && v.Handoff.BeadID != "" {
+		fmt.Printf("handoff_bead: %s\n", v.Handoff.BeadID)
+	}
+	if transcript == nil {
+		return 0
+	}
+
+	t := *transcript
+	fmt.Printf("\ntranscript: %s\n", d.Location(store.KindTranscript, caseID))
+	fmt.Printf("protocol: %s\n", protocolName(t.Protocol))
+	for _, m := range t.Panel {
+		fmt.Printf("seat %s: %s (%s)\n", m.AgentID, m.Perspective, m.Model)
+	}
+	printRounds("", t.InitialPositions, t.Challenges, t.FinalPositions)
+	for _, it := range t.Items {
+		printRounds("item "+it.ItemID+" ", it.InitialPositions, it.Challenges, it.FinalPositions)
+	}
+	return 0
+}
+
+func protocolName(p string) string {
+	if p == "" {
+		return "senate"
+	}
+	return p
+}
+
+func printRounds(prefix string, initial []core.Position, challenges []core.Challenge, final []core.Position) {
+	for _, p := range initial {
+		fmt.Printf("%sinitial %s: %s — %s\n", prefix, p.Perspective, p.Stance, p.Reasoning)
+	}
+	for _, c := range challenges {
+		fmt.Printf("%schallenge %s -> %s: %s\n", prefix, c.From, c.To, c.Challenge)
+	}
+	for _, p := range final {
+		fmt.Printf("%sfinal %s: %s — %s\n", prefix, p.Perspective, p.Stance, p.Reasoning)
+	}
+}
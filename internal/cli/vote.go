@@ -0,0 +1,376 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/deliberation"
+	"github.com/Perttulands/senate/internal/store"
+)
+
+// pauseDeliberation records a deliberation waiting on human seats, writes a
+// prompt file per newly awaited seat and queues a vote request in the outbox.
+func pauseDeliberation(d store.Store, awaiting *deliberation.AwaitingVotesError, opts map[string]string, jsonOut bool, now time.Time) int {
+	caseID := awaiting.Transcript.CaseID
+	c, err := d.LoadCase(caseID)
+	if err != nil {
+		errorf("load case: %v", err)
+		return 1
+	}
+	onTimeout := parseDecision(opts["on-timeout"])
+	if onTimeout == "" {
+		onTimeout = core.DecisionDefer
+	}
+	if awaiting.Item != "" {
+		if c, _ = c.Item(awaiting.Item); c.ID == "" {
+			errorf("case %s has no question %s", caseID, awaiting.Item)
+			return 1
+		}
+	}
+	pending := core.PendingDeliberation{
+		CaseID:     caseID,
+		Status:     core.PendingAwaitingVotes,
+		Round:      awaiting.Round,
+		Item:       awaiting.Item,
+		OpenedAt:   now.Format(time.RFC3339),
+		OnTimeout:  onTimeout,
+		Options:    opts,
+		Transcript: awaiting.Transcript,
+	}
+	pending.Transcript.Anonymization = deliberation.Sealed(awaiting.Transcript.Anonymization)
+	if timeout, err := time.ParseDuration(opts["human-timeout"]); err == nil && timeout > 0 {
+		pending.Deadline = now.Add(timeout).Format(time.RFC3339)
+	}
+	prompted := map[string]struct{}{}
+	if prev, err := d.LoadPending(caseID); err == nil && prev.Round == awaiting.Round && prev.Item == awaiting.Item {
+		pending.OpenedAt = prev.OpenedAt
+		pending.Deadline = prev.Deadline
+		for _, id := range prev.Awaiting {
+			prompted[id] = struct{}{}
+		}
+	}
+	for _, seat := range awaiting.Seats {
+		pending.Awaiting = append(pending.Awaiting, seat.AgentID)
+	}
+	if err := d.SavePending(pending); err != nil {
+		errorf("save pending: %v", err)
+		return 1
+	}
+
+	for _, seat := range awaiting.Seats {
+		if _, ok := prompted[seat.AgentID]; ok {
+			continue
+		}
+		round := awaiting.Round
+		if awaiting.Item != "" {
+			round = awaiting.Item + "-" + round
+		}
+		path, err := d.WritePrompt(caseID, seat.AgentID, round, renderPrompt(c, seat, pending))
+		if err != nil {
+			errorf("write prompt: %v", err)
+			return 1
+		}
+		envelope := map[string]any{
+			"type":        "senate.vote.requested",
+			"case_id":     caseID,
+			"seat":        seat.AgentID,
+			"senator":     seat.Perspective,
+			"round":       awaiting.Round,
+			"item":        awaiting.Item,
+			"deadline":    pending.Deadline,
+			"prompt_file": path,
+			"queued_at":   now.Format(time.RFC3339),
+		}
+		if err := d.AppendOutbox(store.OutboxVoteRequested, envelope); err != nil {
+			errorf("queue vote request: %v", err)
+			return 1
+		}
+	}
+
+	status := caseStatus(d, caseID)
+	if jsonOut {
+		outputJSON(pendingOutput{pending, status})
+		return 0
+	}
+	fmt.Printf("case_id: %s\n", caseID)
+	fmt.Printf("case_status: %s\n", status)
+	fmt.Printf("status: awaiting_votes\n")
+	fmt.Printf("round: %s\n", pending.Round)
+	if pending.Item != "" {
+		fmt.Printf("item: %s\n", pending.Item)
+	}
+	fmt.Printf("awaiting: %s\n", strings.Join(pending.Awaiting, ","))
+	if pending.Deadline != "" {
+		fmt.Printf("deadline: %s (then %s)\n", pending.Deadline, pending.OnTimeout)
+	}
+	fmt.Printf("pending_file: %s\n", d.Location(store.KindPending, caseID))
+	return 0
+}
+
+func renderPrompt(c core.Case, seat core.PanelMember, p core.PendingDeliberation) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Senate vote requested: %s\n\n", c.ID)
+	fmt.Fprintf(&b, "- Seat: %s (%s)\n- Round: %s\n", seat.AgentID, seat.Perspective, p.Round)
+	if p.Item != "" {
+		fmt.Fprintf(&b, "- Item: %s\n", p.Item)
+	}
+	if p.Deadline != "" {
+		fmt.Fprintf(&b, "- Deadline: %s (unanswered seats record `%s`)\n", p.Deadline, p.OnTimeout)
+	}
+	fmt.Fprintf(&b, "\n## Question\n\n%s\n\n## Summary\n\n%s\n", c.Question, c.Summary)
+	if len(c.Options) > 0 {
+		b.WriteString("\n## Options\n\nRank the options with `--ranking a,b,c`, most preferred first:\n\n")
+		for _, o := range c.Options {
+			fmt.Fprintf(&b, "- %s\n", o)
+		}
+	} else if schema := core.OutcomeSchemaFor(c); schema != nil {
+		fmt.Fprintf(&b, "\n## Outcome\n\nAlso vote an outcome with `--outcome`: %s\n", describeSchema(*schema))
+	}
+	if len(c.Evidence) > 0 {
+		b.WriteString("\n## Evidence\n\n")
+		for _, e := range c.Evidence {
+			fmt.Fprintf(&b, "- %s\n", e)
+		}
+	}
+	if c.RequestedDecision != "" {
+		fmt.Fprintf(&b, "\n## Requested decision\n\n%s\n", c.RequestedDecision)
+	}
+	if p.Round == core.RoundFinal {
+		initial, challenges := p.Transcript.InitialPositions, p.Transcript.Challenges
+		for _, it := range p.Transcript.Items {
+			if it.ItemID == p.Item {
+				initial, challenges = it.InitialPositions, it.Challenges
+			}
+		}
+		anon := deliberation.Unsealed(p.Transcript.Anonymization, p.Transcript.Panel)
+		b.WriteString("\n## Initial positions\n\n")
+		for _, pos := range deliberation.PresentPositions(initial, anon) {
+			fmt.Fprintf(&b, "- %s: %s. %s\n", pos.Label, pos.Stance, pos.Reasoning)
+		}
+		for _, ch := range challenges {
+			if ch.To == seat.AgentID || ch.To == deliberation.PanelAddressee {
+				fmt.Fprintf(&b, "\nChallenge from %s: %s\n", deliberation.SeatLabel(ch.From, anon), ch.Challenge)
+			}
+		}
+	}
+	fmt.Fprintf(&b, "\n## How to vote\n\n```\nsenate vote --case-id %s --seat %s --stance <approved|rejected|amended|deferred|abstained> --reasoning \"...\"\n```\n", c.ID, seat.AgentID)
+	return b.String()
+}
+
+func describeSchema(s core.OutcomeSchema) string {
+	if s.Kind == core.OutcomeEnum {
+		return "one of " + strings.Join(s.Options, ", ")
+	}
+	desc := "a number"
+	if s.Min != nil && s.Max != nil {
+		desc = fmt.Sprintf("a number from %s to %s", core.FormatOutcomeNumber(*s.Min), core.FormatOutcomeNumber(*s.Max))
+	}
+	if s.Unit != "" {
+		desc += " (" + s.Unit + ")"
+	}
+	return desc
+}
+
+// recordedBallots resolves human seats from submitted votes, applying the
+// pending timeout policy to seats that missed the deadline.
+type recordedBallots struct {
+	votes   []core.Vote
+	pending core.PendingDeliberation
+	now     time.Time
+}
+
+func (r recordedBallots) Ballot(seat core.PanelMember, round, item string) (deliberation.Assessment, bool) {
+	for i := len(r.votes) - 1; i >= 0; i-- {
+		v := r.votes[i]
+		if v.Round == round && v.Item == item && v.Seat == seat.AgentID {
+			return deliberation.Assessment{Stance: v.Stance, Outcome: v.Outcome, Ranking: v.Ranking, Reasoning: v.Reasoning, Concerns: v.Concerns, Motion: v.Motion}, true
+		}
+	}
+	if r.pending.Round != round || r.pending.Item != item || r.pending.Deadline == "" {
+		return deliberation.Assessment{}, false
+	}
+	deadline, err := time.Parse(time.RFC3339, r.pending.Deadline)
+	if err != nil || r.now.Before(deadline) {
+		return deliberation.Assessment{}, false
+	}
+	return deliberation.Assessment{
+		Stance:    r.pending.OnTimeout,
+		Reasoning: fmt.Sprintf("No vote received before %s; timeout policy recorded %s.", r.pending.Deadline, r.pending.OnTimeout),
+	}, true
+}
+
+// resumeDeliberation continues a paused deliberation with the votes cast so far.
+func resumeDeliberation(d store.Store, caseID string, jsonOut bool, now time.Time) int {
+	pending, err := d.LoadPending(caseID)
+	if err != nil {
+		errorf("load pending: %v", err)
+		return 1
+	}
+	if pending.Status == core.PendingAwaitingEvidence {
+		errorf("case %s is awaiting evidence; answer with senate case amend --case-id %s --evidence ...", caseID, caseID)
+		return 1
+	}
+	if pending.Status == core.PendingAwaitingHandoff && pending.Verdict != nil {
+		return issueVerdict(d, pending.Transcript, *pending.Verdict, pending.Options, pending.Ratification, jsonOut, now)
+	}
+	c, err := d.LoadCase(caseID)
+	if err != nil {
+		errorf("load case: %v", err)
+		return 1
+	}
+	votes, err := d.LoadVotes(caseID)
+	if err != nil {
+		errorf("load votes: %v", err)
+		return 1
+	}
+	engine, err := newEngine(d, deliberation.PanelFromMembers(pending.Transcript.Panel), pending.Options)
+	if err != nil {
+		errorf("%v", err)
+		return 1
+	}
+	engine.JudgeModel = pending.Transcript.JudgeModel
+	engine.Prior = &pending.Transcript
+	engine.Ballots = recordedBallots{votes: sinceLastMotion(votes, pending.Transcript.Motions), pending: pending, now: now}
+	transcript, verdict, err := engine.Deliberate(c, now)
+	return concludeDeliberation(d, transcript, verdict, err, pending.Options, nil, jsonOut, now)
+}
+
+// awaitedSeat resolves --seat to the agent ID of an awaited human seat. A
+// perspective name is accepted only when exactly one awaited seat holds
+// it, so one voter never fills two seats.
+func awaitedSeat(pending core.PendingDeliberation, seat string) (string, error) {
+	awaiting := map[string]bool{}
+	for _, id := range pending.Awaiting {
+		awaiting[id] = true
+	}
+	var named []string
+	for _, m := range pending.Transcript.Panel {
+		if m.Kind != core.SeatHuman || !awaiting[m.AgentID] {
+			continue
+		}
+		if m.AgentID == seat {
+			return m.AgentID, nil
+		}
+		if m.Perspective == seat {
+			named = append(named, m.AgentID)
+		}
+	}
+	switch len(named) {
+	case 0:
+		return "", fmt.Errorf("seat %s is not awaiting a %s vote on %s (awaiting: %s)", seat, pending.Round, pending.CaseID, strings.Join(pending.Awaiting, ","))
+	case 1:
+		return named[0], nil
+	default:
+		return "", fmt.Errorf("%s holds %d awaited seats on %s; vote with --seat %s", seat, len(named), pending.CaseID, strings.Join(named, "|"))
+	}
+}
+
+func cmdVote(args []string) int {
+	flags := parseFlags(args)
+	caseID := strings.TrimSpace(flags["case-id"])
+	seat := strings.TrimSpace(flags["seat"])
+	stance := parseDecision(flags["stance"])
+	reasoning := strings.TrimSpace(flags["reasoning"])
+	if caseID == "" || seat == "" || stance == "" || reasoning == "" {
+		errorf("usage: senate vote --case-id <id> --seat <seat> --stance <decision> --reasoning <text> [--outcome <value>|--ranking a,b,c] [--concerns <text>] [--motion <text>]")
+		return 1
+	}
+	d, err := openStore(flags)
+	if err != nil {
+		errorf("open store: %v", err)
+		return 1
+	}
+	defer d.Close()
+	pending, err := d.LoadPending(caseID)
+	if err != nil {
+		errorf("no deliberation awaiting votes for %s: %v", caseID, err)
+		return 1
+	}
+	if pending.Status == core.PendingAwaitingEvidence {
+		errorf("case %s is awaiting evidence from its filer, not votes", caseID)
+		return 1
+	}
+	if pending.Status == core.PendingAwaitingHandoff {
+		errorf("case %s is decided and awaiting its handoff; retry with senate resume --case-id %s", caseID, caseID)
+		return 1
+	}
+	if seat, err = awaitedSeat(pending, seat); err != nil {
+		errorf("%v", err)
+		return 1
+	}
+
+	c, err := d.LoadCase(caseID)
+	if err != nil {
+		errorf("load case: %v", err)
+		return 1
+	}
+	if pending.Item != "" {
+		c, _ = c.Item(pending.Item)
+	}
+	outcome := strings.TrimSpace(flags["outcome"])
+	var ranking []string
+	if len(c.Options) > 0 {
+		ranking, err = deliberation.NormalizeRanking(c.Options, splitCSV(flags["ranking"]))
+		if err != nil {
+			errorf("vote ranking: %v", err)
+			return 1
+		}
+		outcome = ranking[0]
+	} else if flags["ranking"] != "" {
+		errorf("case %s has no options; omit --ranking", caseID)
+		return 1
+	} else if schema := core.OutcomeSchemaFor(c); schema != nil && outcome != "" {
+		if outcome, err = schema.Normalize(outcome); err != nil {
+			errorf("vote outcome: %v", err)
+			return 1
+		}
+	} else if outcome != "" {
+		errorf("case %s has no outcome schema; omit --outcome", caseID)
+		return 1
+	}
+
+	if flags["motion"] != "" && pending.Round != core.RoundInitial {
+		errorf("motions are raised in the initial round; %s is voting on the %s round", caseID, pending.Round)
+		return 1
+	}
+
+	now := time.Now().UTC()
+	vote := core.Vote{
+		CaseID:    caseID,
+		Seat:      seat,
+		Round:     pending.Round,
+		Item:      pending.Item,
+		Stance:    stance,
+		Outcome:   outcome,
+		Ranking:   ranking,
+		Reasoning: reasoning,
+		Concerns:  strings.TrimSpace(flags["concerns"]),
+		Motion:    strings.TrimSpace(flags["motion"]),
+		CastAt:    now.Format(time.RFC3339),
+	}
+	if err := d.AppendVote(vote); err != nil {
+		errorf("record vote: %v", err)
+		return 1
+	}
+	return resumeDeliberation(d, caseID, flagBool(args, "--json"), now)
+}
+
+// cmdResume continues a paused deliberation, applying the timeout policy to
+// human seats whose deadline has passed.
+func cmdResume(args []string) int {
+	flags := parseFlags(args)
+	caseID := strings.TrimSpace(flags["case-id"])
+	if caseID == "" {
+		errorf("usage: senate resume --case-id <id>")
+		return 1
+	}
+	d, err := openStore(flags)
+	if err != nil {
+		errorf("open store: %v", err)
+		return 1
+	}
+	defer d.Close()
+	return resumeDeliberation(d, caseID, flagBool(args, "--json"), time.Now().UTC())
+}
@@ -0,0 +1,42 @@
+package core
+
+import "fmt"
+
+// Ranked voting methods for multi-option cases.
+const (
+	VotingInstantRunoff = "irv"
+	VotingBorda         = "borda"
+	VotingCondorcet     = "condorcet"
+)
+
+// ValidateVotingMethod accepts a known method or "" (instant-runoff).
+func ValidateVotingMethod(method string) error {
+	switch method {
+	case "", VotingInstantRunoff, VotingBorda, VotingCondorcet:
+		return nil
+	default:
+		return fmt.Errorf("unknown voting method %q (want %s, %s or %s)", method, VotingInstantRunoff, VotingBorda, VotingCondorcet)
+	}
+}
+
+// RankedBallot is one seat's ordered preference over case options.
+type RankedBallot struct {
+	AgentID string   `json:"agent_id"`
+	Ranking []string `json:"ranking"`
+}
+
+// BallotTally is the full ranked-choice count stored in the transcript.
+type BallotTally struct {
+	Method  string         `json:"method"`
+	Options []string       `json:"options"`
+	Ballots []RankedBallot `json:"ballots"`
+	// Rounds holds first-preference counts per instant-runoff round.
+	Rounds []map[string]int `json:"rounds,omitempty"`
+	// Scores holds Borda points per option.
+	Scores map[string]int `json:"scores,omitempty"`
+	// Pairwise[a][b] counts ballots preferring a over b.
+	Pairwise map[string]map[string]int `json:"pairwise,omitempty"`
+	// Fallback names the method used when Condorcet finds no winner.
+	Fallback string `json:"fallback,omitempty"`
+	Winner   string `json:"winner,omitempty"`
+}
@@ -1,12 +1,21 @@
 package core
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"time"
 )
 
-// NewCaseID builds a compact monotonic case identifier.
+// NewCaseID builds a compact case identifier that sorts by filing time. A
+// random suffix keeps cases filed within the same second apart.
 func NewCaseID(now time.Time) string {
 	utc := now.UTC()
-	return fmt.Sprintf("senate-%s", utc.Format("20060102-150405"))
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		// crypto/rand does not fail on supported platforms; fall back to
+		// the sub-second clock rather than colliding on the second.
+		return fmt.Sprintf("senate-%s-%08x", utc.Format("20060102-150405"), uint32(utc.Nanosecond()))
+	}
+	return fmt.Sprintf("senate-%s-%s", utc.Format("20060102-150405"), hex.EncodeToString(suffix))
 }
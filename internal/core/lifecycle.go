@@ -0,0 +1,94 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CaseStatus is where a case stands in its lifecycle.
+type CaseStatus string
+
+const (
+	StatusFiled            CaseStatus = "filed"
+	StatusQueued           CaseStatus = "queued"
+	StatusDeliberating     CaseStatus = "deliberating"
+	StatusAwaitingEvidence CaseStatus = "awaiting_evidence"
+	StatusDecided          CaseStatus = "decided"
+	StatusHandedOff        CaseStatus = "handed_off"
+	StatusImplemented      CaseStatus = "implemented"
+	StatusWithdrawn        CaseStatus = "withdrawn"
+	StatusSuperseded       CaseStatus = "superseded"
+)
+
+// caseTransitions lists the statuses each status may move to. A deliberating
+// case waits in awaiting_evidence while a carried evidence motion is open. A
+// case can be withdrawn until it is decided and superseded at any point;
+// superseded is final.
+var caseTransitions = map[CaseStatus][]CaseStatus{
+	StatusFiled:            {StatusQueued, StatusDeliberating, StatusWithdrawn, StatusSuperseded},
+	StatusQueued:           {StatusDeliberating, StatusWithdrawn, StatusSuperseded},
+	StatusDeliberating:     {StatusDecided, StatusAwaitingEvidence, StatusWithdrawn, StatusSuperseded},
+	StatusAwaitingEvidence: {StatusDeliberating, StatusWithdrawn, StatusSuperseded},
+	StatusDecided:          {StatusHandedOff, StatusImplemented, StatusSuperseded},
+	StatusHandedOff:        {StatusImplemented, StatusSuperseded},
+	StatusImplemented:      {StatusSuperseded},
+	StatusWithdrawn:        {StatusSuperseded},
+	StatusSuperseded:       {},
+}
+
+// CaseStatuses lists every lifecycle status in lifecycle order.
+func CaseStatuses() []CaseStatus {
+	return []CaseStatus{StatusFiled, StatusQueued, StatusDeliberating, StatusAwaitingEvidence, StatusDecided, StatusHandedOff, StatusImplemented, StatusWithdrawn, StatusSuperseded}
+}
+
+// ParseCaseStatus validates a lifecycle status name.
+func ParseCaseStatus(raw string) (CaseStatus, error) {
+	s := CaseStatus(strings.ToLower(strings.TrimSpace(raw)))
+	if _, ok := caseTransitions[s]; !ok {
+		return "", fmt.Errorf("unknown case status %q", raw)
+	}
+	return s, nil
+}
+
+// CanTransition reports whether the lifecycle allows moving from one status
+// to another.
+func CanTransition(from, to CaseStatus) bool {
+	for _, next := range caseTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Transition records one lifecycle change of a case.
+type Transition struct {
+	From  CaseStatus `json:"from,omitempty"`
+	To    CaseStatus `json:"to"`
+	At    string     `json:"at"`
+	Actor string     `json:"actor"`
+	Note  string     `json:"note,omitempty"`
+}
+
+// Advance moves the case to status to, appending the transition to its
+// history. A case without a status can only be filed.
+func (c *Case) Advance(to CaseStatus, at time.Time, actor, note string) error {
+	if strings.TrimSpace(actor) == "" {
+		return fmt.Errorf("case %s: transition to %s needs an actor", c.ID, to)
+	}
+	from := c.Status
+	if from == "" && to != StatusFiled || from != "" && !CanTransition(from, to) {
+		return fmt.Errorf("case %s cannot move from %s to %s", c.ID, statusName(from), to)
+	}
+	c.Status = to
+	c.History = append(c.History, Transition{From: from, To: to, At: at.UTC().Format(time.RFC3339), Actor: actor, Note: note})
+	return nil
+}
+
+func statusName(s CaseStatus) string {
+	if s == "" {
+		return "unfiled"
+	}
+	return string(s)
+}
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCaseAdvanceFollowsLifecycle(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	c := Case{ID: "senate-1"}
+	if err := c.Advance(StatusDecided, now, "senate", ""); err == nil {
+		t.Fatal("expected an unfiled case to only accept filed")
+	}
+	for _, to := range []CaseStatus{StatusFiled, StatusDeliberating, StatusDecided, StatusImplemented} {
+		if err := c.Advance(to, now, "athena", ""); err != nil {
+			t.Fatalf("advance to %s: %v", to, err)
+		}
+	}
+	if err := c.Advance(StatusWithdrawn, now, "athena", ""); err == nil {
+		t.Fatal("expected an implemented case not to be withdrawn")
+	}
+	if err := c.Advance(StatusSuperseded, now, "", ""); err == nil {
+		t.Fatal("expected a transition without an actor to be refused")
+	}
+	if c.Status != StatusImplemented || len(c.History) != 4 || c.History[3].From != StatusDecided {
+		t.Fatalf("unexpected lifecycle %s %+v", c.Status, c.History)
+	}
+}
+
+func TestParseCaseStatus(t *testing.T) {
+	if s, err := ParseCaseStatus(" Handed_Off "); err != nil || s != StatusHandedOff {
+		t.Fatalf("expected handed_off, got %q (%v)", s, err)
+	}
+	if _, err := ParseCaseStatus("closed"); err == nil {
+		t.Fatal("expected unknown status to be rejected")
+	}
+}
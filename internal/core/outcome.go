@@ -0,0 +1,113 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Outcome schema kinds.
+const (
+	OutcomeEnum   = "enum"
+	OutcomeNumber = "number"
+)
+
+// OutcomeSchema declares a case type's own outcome vocabulary. Panels vote on
+// an outcome value alongside their classic Decision stance.
+type OutcomeSchema struct {
+	Kind    string   `json:"kind"`
+	Options []string `json:"options,omitempty"`
+	Min     *float64 `json:"min,omitempty"`
+	Max     *float64 `json:"max,omitempty"`
+	Unit    string   `json:"unit,omitempty"`
+}
+
+func float64Ptr(v float64) *float64 { return &v }
+
+// outcomeSchemas are the built-in schemas for known case types. Types not
+// listed use the four classic decisions only.
+var outcomeSchemas = map[string]OutcomeSchema{
+	"priority_triage": {Kind: OutcomeEnum, Options: []string{"P0", "P1", "P2", "P3"}},
+	"gate_criteria":   {Kind: OutcomeNumber, Min: float64Ptr(0), Max: float64Ptr(100), Unit: "%"},
+}
+
+// OutcomeSchemaFor returns an enum of the case's options for multi-option
+// cases, else the case's own schema, else its type's built-in
+// schema, else nil for classic-decision cases.
+func OutcomeSchemaFor(c Case) *OutcomeSchema {
+	if len(c.Options) > 0 {
+		return &OutcomeSchema{Kind: OutcomeEnum, Options: c.Options}
+	}
+	if c.OutcomeSchema != nil {
+		return c.OutcomeSchema
+	}
+	if s, ok := outcomeSchemas[c.Type]; ok {
+		return &s
+	}
+	return nil
+}
+
+func (s OutcomeSchema) Validate() error {
+	switch s.Kind {
+	case OutcomeEnum:
+		if len(s.Options) == 0 {
+			return errors.New("outcome_schema.options must not be empty for enum")
+		}
+		seen := map[string]struct{}{}
+		for i, o := range s.Options {
+			key := strings.ToLower(strings.TrimSpace(o))
+			if key == "" {
+				return fmt.Errorf("outcome_schema.options[%d] must not be empty", i)
+			}
+			if _, dup := seen[key]; dup {
+				return fmt.Errorf("outcome_schema.options[%d] duplicates %q", i, o)
+			}
+			seen[key] = struct{}{}
+		}
+	case OutcomeNumber:
+		if s.Min != nil && s.Max != nil && *s.Min > *s.Max {
+			return errors.New("outcome_schema.min must not exceed max")
+		}
+	default:
+		return fmt.Errorf("outcome_schema.kind must be %s or %s", OutcomeEnum, OutcomeNumber)
+	}
+	return nil
+}
+
+// Normalize checks a value against the schema and returns its canonical form:
+// the declared option spelling for enums, a trimmed decimal for numbers.
+func (s OutcomeSchema) Normalize(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", errors.New("outcome value is empty")
+	}
+	switch s.Kind {
+	case OutcomeEnum:
+		for _, o := range s.Options {
+			if strings.EqualFold(o, value) {
+				return o, nil
+			}
+		}
+		return "", fmt.Errorf("outcome %q is not one of %s", value, strings.Join(s.Options, ", "))
+	case OutcomeNumber:
+		n, err := strconv.ParseFloat(strings.TrimSuffix(value, s.Unit), 64)
+		if err != nil {
+			return "", fmt.Errorf("outcome %q is not a number", value)
+		}
+		if s.Min != nil && n < *s.Min {
+			return "", fmt.Errorf("outcome %s is below minimum %s", value, FormatOutcomeNumber(*s.Min))
+		}
+		if s.Max != nil && n > *s.Max {
+			return "", fmt.Errorf("outcome %s is above maximum %s", value, FormatOutcomeNumber(*s.Max))
+		}
+		return FormatOutcomeNumber(n), nil
+	default:
+		return "", fmt.Errorf("unknown outcome kind %q", s.Kind)
+	}
+}
+
+// FormatOutcomeNumber renders a numeric outcome without trailing zeros.
+func FormatOutcomeNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
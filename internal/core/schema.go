@@ -0,0 +1,121 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// SchemaVersion is the version of every document Senate writes. Version 0
+// is any document stored before documents were versioned.
+const SchemaVersion = 1
+
+// Document kinds that carry a schema version.
+const (
+	DocCase       = "case"
+	DocTranscript = "transcript"
+	DocVerdict    = "verdict"
+	DocPending    = "pending"
+	DocVote       = "vote"
+	DocPrecedent  = "precedent"
+)
+
+// ErrNewerSchema is wrapped by Decode for documents written by a newer
+// Senate than this one.
+var ErrNewerSchema = errors.New("document has a newer schema_version; upgrade senate")
+
+// upgrades[kind][v] rewrites a kind's raw document from schema version v to
+// v+1. A missing step means the document reads unchanged; version 0 to 1
+// only introduced the version field itself.
+var upgrades = map[string]map[int]func(doc map[string]any) error{}
+
+// Decode unmarshals a stored document of kind into v, first applying every
+// upgrade from the version it was stored at. The decoded schema_version is
+// left at the stored version so callers can tell what needs rewriting;
+// stores write the current version on save. Documents from a newer Senate
+// are refused rather than read lossily.
+func Decode(kind string, data []byte, v any) error {
+	var head struct {
+		SchemaVersion int `json:"schema_version"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return err
+	}
+	if head.SchemaVersion > SchemaVersion {
+		return fmt.Errorf("%s schema_version %d (supported %d): %w", kind, head.SchemaVersion, SchemaVersion, ErrNewerSchema)
+	}
+	steps := upgrades[kind]
+	for from := head.SchemaVersion; from < SchemaVersion; from++ {
+		up := steps[from]
+		if up == nil {
+			continue
+		}
+		var doc map[string]any
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return err
+		}
+		if err := up(doc); err != nil {
+			return fmt.Errorf("upgrade %s from schema_version %d: %w", kind, from, err)
+		}
+		doc["schema_version"] = head.SchemaVersion
+		var err error
+		if data, err = json.Marshal(doc); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Canonical is the encoding documents are hashed and signed in, so a hash
+// or signature survives a re-encoding by another Senate build. It is the
+// document's JSON with object keys sorted, no insignificant whitespace,
+// numbers as written, and both schema_version and unset members (null,
+// false, 0, "", [] and {}) left out: a schema bump or a field added later
+// does not change documents that leave the field unset.
+func Canonical(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if m, ok := doc.(map[string]any); ok {
+		delete(m, "schema_version")
+	}
+	doc, _ = prune(doc)
+	return json.Marshal(doc)
+}
+
+// prune drops unset members from objects, recursively, and reports whether
+// v itself is unset. Array elements are kept so positions do not shift.
+func prune(v any) (any, bool) {
+	switch t := v.(type) {
+	case nil:
+		return nil, true
+	case bool:
+		return t, !t
+	case string:
+		return t, t == ""
+	case json.Number:
+		f, err := strconv.ParseFloat(string(t), 64)
+		return t, err == nil && f == 0
+	case []any:
+		for i := range t {
+			t[i], _ = prune(t[i])
+		}
+		return t, len(t) == 0
+	case map[string]any:
+		for k, member := range t {
+			pruned, unset := prune(member)
+			if unset {
+				delete(t, k)
+				continue
+			}
+			t[k] = pruned
+		}
+		return t, len(t) == 0
+	}
+	return v, false
+}
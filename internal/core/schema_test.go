@@ -0,0 +1,72 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestDecodeUpgradesOlderDocuments(t *testing.T) {
+	saved := upgrades[DocCase]
+	defer func() { upgrades[DocCase] = saved }()
+	upgrades[DocCase] = map[int]func(map[string]any) error{
+		0: func(doc map[string]any) error {
+			doc["summary"] = doc["title"]
+			return nil
+		},
+	}
+
+	var c Case
+	if err := Decode(DocCase, []byte(`{"id":"senate-1","title":"Legacy summary"}`), &c); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if c.Summary != "Legacy summary" || c.SchemaVersion != 0 {
+		t.Fatalf("expected upgraded summary at stored version 0, got %+v", c)
+	}
+	var current Case
+	if err := Decode(DocCase, []byte(`{"schema_version":1,"id":"senate-2","title":"ignored"}`), &current); err != nil || current.Summary != "" || current.SchemaVersion != 1 {
+		t.Fatalf("expected current document read as-is, got %+v (%v)", current, err)
+	}
+}
+
+func TestDecodeRefusesNewerSchema(t *testing.T) {
+	var v Verdict
+	err := Decode(DocVerdict, []byte(`{"schema_version":99,"case_id":"senate-1"}`), &v)
+	if !errors.Is(err, ErrNewerSchema) {
+		t.Fatalf("expected ErrNewerSchema, got %v", err)
+	}
+}
+
+func TestCanonicalIgnoresEncodingAndUnsetFields(t *testing.T) {
+	v := Verdict{SchemaVersion: SchemaVersion, CaseID: "senate-1", Verdict: DecisionApprove, Binding: true, Ensemble: &EnsembleSummary{Size: 3, Agreement: 2.0 / 3.0}}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := Canonical(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The same verdict as written by another build: indented, keys in another
+	// order, an older schema_version and a field this build does not set.
+	other := []byte(`{
+  "binding": true,
+  "verdict": "approved",
+  "case_id": "senate-1",
+  "schema_version": 0,
+  "ensemble": {"agreement": 0.6666666666666666, "size": 3, "runs": []},
+  "future_field": ""
+}`)
+	got, err := Canonical(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Fatalf("expected equal canonical forms:\n%s\n%s", got, want)
+	}
+	v.Verdict = DecisionReject
+	data, _ = json.Marshal(v)
+	if changed, _ := Canonical(data); string(changed) == string(want) {
+		t.Fatal("expected a changed verdict to change its canonical form")
+	}
+}
@@ -17,6 +17,10 @@ const (
 	DecisionDefer   Decision = "deferred"
 )
 
+// DecisionAbstain is a seat stance that takes no side. It is never a verdict
+// and is excluded from tallies.
+const DecisionAbstain Decision = "abstained"
+
 func (d Decision) Validate() error {
 	switch d {
 	case DecisionApprove, DecisionReject, DecisionAmend, DecisionDefer:
@@ -26,8 +30,38 @@ func (d Decision) Validate() error {
 	}
 }
 
+// ValidateStance accepts any verdict decision or an abstention.
+func (d Decision) ValidateStance() error {
+	if d == DecisionAbstain {
+		return nil
+	}
+	return d.Validate()
+}
+
+// ParseDecision accepts verb or past-tense forms ("approve", "approved").
+// It returns "" for anything else.
+func ParseDecision(raw string) Decision {
+	switch strings.TrimSpace(strings.ToLower(raw)) {
+	case "approve", "approved":
+		return DecisionApprove
+	case "reject", "rejected":
+		return DecisionReject
+	case "amend", "amended":
+		return DecisionAmend
+	case "defer", "deferred":
+		return DecisionDefer
+	case "abstain", "abstained":
+		return DecisionAbstain
+	default:
+		return ""
+	}
+}
+
 // Case is a normalized Senate case file.
 type Case struct {
+	// SchemaVersion is the version the document was stored at (see the
+	// SchemaVersion constant); stores write the current one.
+	SchemaVersion     int      `json:"schema_version,omitempty"`
 	ID                string   `json:"id"`
 	Type              string   `json:"type"`
 	Summary           string   `json:"summary"`
@@ -36,6 +70,46 @@ type Case struct {
 	RequestedDecision string   `json:"requested_decision,omitempty"`
 	FiledAt           string   `json:"filed_at"`
 	FiledBy           string   `json:"filed_by,omitempty"`
+	// OutcomeSchema overrides the built-in outcome vocabulary for the case type.
+	OutcomeSchema *OutcomeSchema `json:"outcome_schema,omitempty"`
+	// Options turn the case into a multi-option choice decided by ranked voting.
+	Options      []string `json:"options,omitempty"`
+	VotingMethod string   `json:"voting_method,omitempty"`
+	// Questions split the case into sub-questions decided independently.
+	Questions []SubQuestion `json:"questions,omitempty"`
+	// Conflicts names seats (agent ids or senator names) that must recuse.
+	Conflicts []string `json:"conflicts,omitempty"`
+	// Supersedes is the ID of an earlier case this one replaces.
+	Supersedes string `json:"supersedes,omitempty"`
+	// Status and History track the case lifecycle; Senate sets them.
+	Status  CaseStatus   `json:"status,omitempty"`
+	History []Transition `json:"history,omitempty"`
+}
+
+// SubQuestion is one independently decided item of a multi-question case.
+type SubQuestion struct {
+	ID                string   `json:"id"`
+	Question          string   `json:"question"`
+	RequestedDecision string   `json:"requested_decision,omitempty"`
+	Options           []string `json:"options,omitempty"`
+}
+
+// Item projects a sub-question onto a standalone case for deliberation.
+// It returns false when the case has no sub-question with that ID.
+func (c Case) Item(id string) (Case, bool) {
+	for _, q := range c.Questions {
+		if q.ID != id {
+			continue
+		}
+		sub := c
+		sub.Question = q.Question
+		sub.Summary = q.Question
+		sub.RequestedDecision = q.RequestedDecision
+		sub.Options = q.Options
+		sub.Questions = nil
+		return sub, true
+	}
+	return Case{}, false
 }
 
 func (c *Case) Normalize(now time.Time) {
@@ -58,6 +132,24 @@ func (c *Case) Normalize(now time.Time) {
 	if strings.TrimSpace(c.FiledAt) == "" {
 		c.FiledAt = now.UTC().Format(time.RFC3339)
 	}
+	for i := range c.Questions {
+		q := &c.Questions[i]
+		q.ID = strings.TrimSpace(q.ID)
+		if q.ID == "" {
+			q.ID = fmt.Sprintf("q%d", i+1)
+		}
+		q.Question = strings.TrimSpace(q.Question)
+	}
+	if c.Question == "" && len(c.Questions) > 0 {
+		parts := make([]string, 0, len(c.Questions))
+		for _, q := range c.Questions {
+			parts = append(parts, q.Question)
+		}
+		c.Question = strings.Join(parts, " ")
+		if c.Summary == "" {
+			c.Summary = c.Question
+		}
+	}
 }
 
 func (c Case) Validate() error {
@@ -79,19 +171,82 @@ func (c Case) Validate() error {
 	if _, err := time.Parse(time.RFC3339, c.FiledAt); err != nil {
 		return fmt.Errorf("case.filed_at must be RFC3339: %w", err)
 	}
+	if c.Status != "" {
+		if _, err := ParseCaseStatus(string(c.Status)); err != nil {
+			return fmt.Errorf("case.status: %w", err)
+		}
+	}
 	for i, e := range c.Evidence {
 		if strings.TrimSpace(e) == "" {
 			return fmt.Errorf("case.evidence[%d] must not be empty", i)
 		}
 	}
+	if c.OutcomeSchema != nil {
+		if err := c.OutcomeSchema.Validate(); err != nil {
+			return fmt.Errorf("case.%w", err)
+		}
+	}
+	if len(c.Options) > 0 {
+		if c.OutcomeSchema != nil {
+			return errors.New("case.options and case.outcome_schema are mutually exclusive")
+		}
+		if len(c.Options) < 2 {
+			return errors.New("case.options needs at least two options")
+		}
+		if err := (OutcomeSchema{Kind: OutcomeEnum, Options: c.Options}).Validate(); err != nil {
+			return fmt.Errorf("case.options: %w", err)
+		}
+	}
+	if err := ValidateVotingMethod(c.VotingMethod); err != nil {
+		return fmt.Errorf("case.voting_method: %w", err)
+	}
+	seen := map[string]struct{}{}
+	for i, q := range c.Questions {
+		if strings.TrimSpace(q.ID) == "" || strings.ContainsAny(q.ID, "#/ ") {
+			return fmt.Errorf("case.questions[%d].id must be non-empty without '#', '/' or spaces", i)
+		}
+		if _, dup := seen[q.ID]; dup {
+			return fmt.Errorf("case.questions[%d].id %q is duplicated", i, q.ID)
+		}
+		seen[q.ID] = struct{}{}
+		if strings.TrimSpace(q.Question) == "" {
+			return fmt.Errorf("case.questions[%d].question is required", i)
+		}
+		if len(q.Options) == 1 {
+			return fmt.Errorf("case.questions[%d].options needs at least two options", i)
+		}
+		if len(q.Options) > 0 {
+			if err := (OutcomeSchema{Kind: OutcomeEnum, Options: q.Options}).Validate(); err != nil {
+				return fmt.Errorf("case.questions[%d].options: %w", i, err)
+			}
+		}
+	}
+	for i, name := range c.Conflicts {
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("case.conflicts[%d] must not be empty", i)
+		}
+	}
+	if len(c.Questions) > 0 && len(c.Options) > 0 {
+		return errors.New("case.options and case.questions are mutually exclusive; put options on each question")
+	}
 	return nil
 }
 
+// SeatHuman marks a panel seat filled by a human senator rather than an agent.
+const SeatHuman = "human"
+
+// Deliberation round names.
+const (
+	RoundInitial = "initial"
+	RoundFinal   = "final"
+)
+
 // PanelMember captures one deliberation participant.
 type PanelMember struct {
 	AgentID     string `json:"agent_id"`
 	Model       string `json:"model"`
 	Perspective string `json:"perspective"`
+	Kind        string `json:"kind,omitempty"`
 }
 
 // Position captures an agent position at a specific round.
@@ -101,8 +256,12 @@ type Position struct {
 	Perspective string   `json:"perspective"`
 	Round       string   `json:"round"`
 	Stance      Decision `json:"stance"`
+	Outcome     string   `json:"outcome,omitempty"`
+	Ranking     []string `json:"ranking,omitempty"`
 	Reasoning   string   `json:"reasoning"`
 	Concerns    string   `json:"concerns,omitempty"`
+	// Motion is the seat's request for more evidence from the filer, if any.
+	Motion string `json:"motion,omitempty"`
 }
 
 // Challenge captures one direct challenge between agents.
@@ -114,6 +273,9 @@ type Challenge struct {
 
 // Transcript is the auditable deliberation output.
 type Transcript struct {
+	// SchemaVersion is the version the document was stored at (see the
+	// SchemaVersion constant); stores write the current one.
+	SchemaVersion    int           `json:"schema_version,omitempty"`
 	CaseID           string        `json:"case_id"`
 	StartedAt        string        `json:"started_at"`
 	CompletedAt      string        `json:"completed_at"`
@@ -122,6 +284,205 @@ type Transcript struct {
 	Challenges       []Challenge   `json:"challenges"`
 	FinalPositions   []Position    `json:"final_positions"`
 	JudgeModel       string        `json:"judge_model"`
+	// Protocol names the deliberation protocol; empty means senate.
+	Protocol string `json:"protocol,omitempty"`
+	// Anonymization maps anonymous labels back to seats when positions were
+	// presented anonymously; it is stored only here.
+	Anonymization *Anonymization `json:"anonymization,omitempty"`
+	// Recusals lists seats withdrawn for a conflict; they hold no positions.
+	Recusals []Recusal `json:"recusals,omitempty"`
+	// ToolCalls logs every tool a seat called, including refused calls.
+	ToolCalls []ToolCall `json:"tool_calls,omitempty"`
+	// Motions records each carried request for more evidence and its answer.
+	Motions []EvidenceMotion `json:"motions,omitempty"`
+	Quorum  *Quorum          `json:"quorum,omitempty"`
+	Vetoes  []VetoRecord     `json:"vetoes,omitempty"`
+	Ballot  *BallotTally     `json:"ballot,omitempty"`
+	// Items hold each sub-question's rounds for multi-question cases; the
+	// top-level rounds are then empty and Metrics averages the items.
+	Items   []ItemTranscript `json:"items,omitempty"`
+	Metrics *Metrics         `json:"metrics,omitempty"`
+}
+
+// Anonymization records how positions were presented to other seats: the
+// seed, the label shown for each agent id, and the shuffled display order.
+type Anonymization struct {
+	Seed   int64             `json:"seed"`
+	Labels map[string]string `json:"labels"`
+	Order  []string          `json:"order"`
+}
+
+// ItemTranscript is the deliberation record for one sub-question.
+type ItemTranscript struct {
+	ItemID           string       `json:"item_id"`
+	Question         string       `json:"question"`
+	InitialPositions []Position   `json:"initial_positions"`
+	Challenges       []Challenge  `json:"challenges"`
+	FinalPositions   []Position   `json:"final_positions"`
+	ToolCalls        []ToolCall   `json:"tool_calls,omitempty"`
+	Quorum           *Quorum      `json:"quorum,omitempty"`
+	Vetoes           []VetoRecord `json:"vetoes,omitempty"`
+	Ballot           *BallotTally `json:"ballot,omitempty"`
+	Metrics          *Metrics     `json:"metrics,omitempty"`
+}
+
+// VetoRecord captures a seat vetoing the panel's verdict.
+type VetoRecord struct {
+	AgentID     string   `json:"agent_id"`
+	Perspective string   `json:"perspective"`
+	Blocked     Decision `json:"blocked"`
+	Fallback    Decision `json:"fallback"`
+	Reasoning   string   `json:"reasoning"`
+}
+
+// EnsembleRun is one independent panel run of an ensemble deliberation.
+type EnsembleRun struct {
+	Run     int      `json:"run"`
+	Seed    int64    `json:"seed"`
+	Verdict Decision `json:"verdict"`
+	Outcome string   `json:"outcome,omitempty"`
+}
+
+// EnsembleSummary aggregates the runs behind an ensemble verdict. Agreement
+// is the share of runs reaching the final verdict; Outcomes counts each
+// outcome value across runs.
+type EnsembleSummary struct {
+	Size      int              `json:"size"`
+	Agreement float64          `json:"agreement"`
+	Threshold float64          `json:"threshold"`
+	Verdicts  map[Decision]int `json:"verdicts"`
+	Outcomes  map[string]int   `json:"outcomes,omitempty"`
+	Runs      []EnsembleRun    `json:"runs"`
+}
+
+// EvidenceMotion is a carried panel request for more evidence from the filer.
+// Evidence and AnsweredAt are set when the filer amends the case.
+type EvidenceMotion struct {
+	Item       string            `json:"item,omitempty"`
+	Requests   []EvidenceRequest `json:"requests"`
+	RaisedAt   string            `json:"raised_at"`
+	Evidence   []string          `json:"evidence,omitempty"`
+	AnsweredAt string            `json:"answered_at,omitempty"`
+}
+
+// EvidenceRequest is one seat's request within a motion.
+type EvidenceRequest struct {
+	AgentID     string `json:"agent_id"`
+	Perspective string `json:"perspective"`
+	Request     string `json:"request"`
+}
+
+// ToolCall records one tool invocation by a seat and its result or error.
+type ToolCall struct {
+	AgentID string            `json:"agent_id"`
+	Round   string            `json:"round"`
+	Tool    string            `json:"tool"`
+	Args    map[string]string `json:"args,omitempty"`
+	Result  string            `json:"result,omitempty"`
+	Error   string            `json:"error,omitempty"`
+}
+
+// Recusal records a seat withdrawn from a case before the first round.
+type Recusal struct {
+	AgentID     string `json:"agent_id"`
+	Perspective string `json:"perspective"`
+	Reason      string `json:"reason"`
+}
+
+// Quorum is the seat count behind a decision. Eligible excludes recused
+// seats; Voting further excludes abstentions. Required is a majority of
+// Eligible, and a decision without it defers.
+type Quorum struct {
+	Seats     int  `json:"seats"`
+	Recused   int  `json:"recused"`
+	Eligible  int  `json:"eligible"`
+	Abstained int  `json:"abstained"`
+	Voting    int  `json:"voting"`
+	Required  int  `json:"required"`
+	Met       bool `json:"met"`
+}
+
+// Metrics measures deliberation quality for one transcript.
+type Metrics struct {
+	InitialAgreement     float64 `json:"initial_agreement"`
+	FinalAgreement       float64 `json:"final_agreement"`
+	StanceChanges        int     `json:"stance_changes"`
+	ChallengeCoverage    float64 `json:"challenge_coverage"`
+	EvidenceCitationRate float64 `json:"evidence_citation_rate"`
+	MeanReasoningWords   float64 `json:"mean_reasoning_words"`
+	// Abstentions counts final positions that abstained; agreement is
+	// measured over the remaining seats only.
+	Abstentions int `json:"abstentions,omitempty"`
+}
+
+// Vote is a human senator's submitted position for one round.
+type Vote struct {
+	// SchemaVersion is the version the document was stored at (see the
+	// SchemaVersion constant); stores write the current one.
+	SchemaVersion int      `json:"schema_version,omitempty"`
+	CaseID        string   `json:"case_id"`
+	Seat          string   `json:"seat"`
+	Round         string   `json:"round"`
+	Item          string   `json:"item,omitempty"`
+	Stance        Decision `json:"stance"`
+	Outcome       string   `json:"outcome,omitempty"`
+	Ranking       []string `json:"ranking,omitempty"`
+	Reasoning     string   `json:"reasoning"`
+	Concerns      string   `json:"concerns,omitempty"`
+	Motion        string   `json:"motion,omitempty"`
+	CastAt        string   `json:"cast_at"`
+}
+
+func (v Vote) Validate() error {
+	if strings.TrimSpace(v.CaseID) == "" {
+		return errors.New("vote.case_id is required")
+	}
+	if strings.TrimSpace(v.Seat) == "" {
+		return errors.New("vote.seat is required")
+	}
+	if v.Round != RoundInitial && v.Round != RoundFinal {
+		return fmt.Errorf("vote.round must be %s or %s", RoundInitial, RoundFinal)
+	}
+	if err := v.Stance.ValidateStance(); err != nil {
+		return fmt.Errorf("vote.stance: %w", err)
+	}
+	if strings.TrimSpace(v.Reasoning) == "" {
+		return errors.New("vote.reasoning is required")
+	}
+	return nil
+}
+
+// Pending deliberation states.
+const (
+	PendingAwaitingVotes        = "awaiting_votes"
+	PendingAwaitingEvidence     = "awaiting_evidence"
+	PendingAwaitingRatification = "awaiting_ratification"
+	PendingAwaitingHandoff      = "awaiting_handoff"
+)
+
+// PendingDeliberation is a deliberation paused until human seats vote,
+// after a carried evidence motion until the filer amends the case, or after
+// an expedited verdict until the full panel ratifies it, or after a decided
+// verdict whose handoff or signing failed until it is retried.
+type PendingDeliberation struct {
+	// SchemaVersion is the version the document was stored at (see the
+	// SchemaVersion constant); stores write the current one.
+	SchemaVersion int               `json:"schema_version,omitempty"`
+	CaseID        string            `json:"case_id"`
+	Status        string            `json:"status,omitempty"`
+	Motion        *EvidenceMotion   `json:"motion,omitempty"`
+	Round         string            `json:"round"`
+	Item          string            `json:"item,omitempty"`
+	Awaiting      []string          `json:"awaiting"`
+	OpenedAt      string            `json:"opened_at"`
+	Deadline      string            `json:"deadline,omitempty"`
+	OnTimeout     Decision          `json:"on_timeout"`
+	Options       map[string]string `json:"options,omitempty"`
+	Transcript    Transcript        `json:"transcript"`
+	// Verdict and Ratification hold a decided verdict awaiting its handoff
+	// and the ratification it schedules once issued.
+	Verdict      *Verdict             `json:"verdict,omitempty"`
+	Ratification *PendingDeliberation `json:"ratification,omitempty"`
 }
 
 // Handoff stores implementation tracking metadata.
@@ -134,19 +495,116 @@ type Handoff struct {
 
 // Verdict is the binding Senate result.
 type Verdict struct {
-	CaseID         string     `json:"case_id"`
-	FiledAt        string     `json:"filed_at"`
-	VerdictAt      string     `json:"verdict_at"`
-	Type           string     `json:"type"`
-	Summary        string     `json:"summary"`
-	Verdict        Decision   `json:"verdict"`
-	Reasoning      string     `json:"reasoning"`
-	Implementation string     `json:"implementation"`
-	Dissent        string     `json:"dissent,omitempty"`
-	Binding        bool       `json:"binding"`
-	Judge          string     `json:"judge"`
-	FinalPositions []Position `json:"final_positions"`
-	Handoff        *Handoff   `json:"handoff,omitempty"`
+	// SchemaVersion is the version the document was stored at (see the
+	// SchemaVersion constant); stores write the current one.
+	SchemaVersion int      `json:"schema_version,omitempty"`
+	CaseID        string   `json:"case_id"`
+	FiledAt       string   `json:"filed_at"`
+	VerdictAt     string   `json:"verdict_at"`
+	Type          string   `json:"type"`
+	Summary       string   `json:"summary"`
+	Verdict       Decision `json:"verdict"`
+	// Outcome is the typed value chosen for cases with an OutcomeSchema.
+	Outcome        string         `json:"outcome,omitempty"`
+	OutcomeSchema  *OutcomeSchema `json:"outcome_schema,omitempty"`
+	Reasoning      string         `json:"reasoning"`
+	Implementation string         `json:"implementation"`
+	Dissent        string         `json:"dissent,omitempty"`
+	Binding        bool           `json:"binding"`
+	Judge          string         `json:"judge"`
+	FinalPositions []Position     `json:"final_positions"`
+	Handoff        *Handoff       `json:"handoff,omitempty"`
+	// Items carry per-sub-question decisions for multi-question cases.
+	Items []ItemVerdict `json:"items,omitempty"`
+	// Ensemble summarizes the independent runs behind an ensemble verdict.
+	Ensemble *EnsembleSummary `json:"ensemble,omitempty"`
+	// Provisional marks an expedited single-judge verdict that binds until
+	// a full panel ratifies it.
+	Provisional bool `json:"provisional,omitempty"`
+	// Ratification records how the full panel settled a provisional verdict.
+	Ratification *Ratification `json:"ratification,omitempty"`
+	// Signature is Senate's signature over the rest of the verdict, set
+	// when the state root has a signing key.
+	Signature *Signature `json:"signature,omitempty"`
+}
+
+// Signature proves a verdict was issued by the holder of a Senate signing
+// key. Value is the base64 signature over the verdict's Canonical encoding
+// without Value, so the key and signing time are covered too.
+type Signature struct {
+	KeyID     string `json:"key_id"`
+	Algorithm string `json:"algorithm"`
+	SignedAt  string `json:"signed_at"`
+	Value     string `json:"value"`
+}
+
+// Ratification outcomes.
+const (
+	RatificationConfirmed  = "confirmed"
+	RatificationOverturned = "overturned"
+)
+
+// Ratification compares a full-panel verdict with the provisional verdict
+// it replaces.
+type Ratification struct {
+	Status             string   `json:"status"`
+	ProvisionalVerdict Decision `json:"provisional_verdict"`
+	ProvisionalOutcome string   `json:"provisional_outcome,omitempty"`
+	ProvisionalAt      string   `json:"provisional_at"`
+	RatifiedAt         string   `json:"ratified_at"`
+	// ProvisionalBeads are the beads handed off for an overturned
+	// provisional verdict; Senate closes them unless handoff is disabled.
+	ProvisionalBeads []string `json:"provisional_beads,omitempty"`
+}
+
+// ItemVerdict is the decision on one sub-question of a compound verdict.
+type ItemVerdict struct {
+	ItemID         string         `json:"item_id"`
+	Question       string         `json:"question"`
+	Verdict        Decision       `json:"verdict"`
+	Outcome        string         `json:"outcome,omitempty"`
+	OutcomeSchema  *OutcomeSchema `json:"outcome_schema,omitempty"`
+	Reasoning      string         `json:"reasoning"`
+	Implementation string         `json:"implementation"`
+	Dissent        string         `json:"dissent,omitempty"`
+	Binding        bool           `json:"binding"`
+	FinalPositions []Position     `json:"final_positions"`
+	Handoff        *Handoff       `json:"handoff,omitempty"`
+}
+
+// ItemRef addresses one sub-question as "<case_id>#<item_id>".
+func ItemRef(caseID, itemID string) string {
+	return caseID + "#" + itemID
+}
+
+// Item projects one sub-question decision onto a standalone verdict whose
+// CaseID is the item reference, so precedent and handoff can address it.
+func (v Verdict) Item(id string) (Verdict, bool) {
+	for _, it := range v.Items {
+		if it.ItemID != id {
+			continue
+		}
+		return Verdict{
+			CaseID:         ItemRef(v.CaseID, it.ItemID),
+			FiledAt:        v.FiledAt,
+			VerdictAt:      v.VerdictAt,
+			Type:           v.Type,
+			Summary:        it.Question,
+			Verdict:        it.Verdict,
+			Outcome:        it.Outcome,
+			OutcomeSchema:  it.OutcomeSchema,
+			Reasoning:      it.Reasoning,
+			Implementation: it.Implementation,
+			Dissent:        it.Dissent,
+			Binding:        it.Binding,
+			Judge:          v.Judge,
+			FinalPositions: it.FinalPositions,
+			Handoff:        it.Handoff,
+			Provisional:    v.Provisional,
+			Ratification:   v.Ratification,
+		}, true
+	}
+	return Verdict{}, false
 }
 
 func (v Verdict) Validate() error {
@@ -174,6 +632,25 @@ func (v Verdict) Validate() error {
 	if err := v.Verdict.Validate(); err != nil {
 		return fmt.Errorf("verdict.verdict: %w", err)
 	}
+	if v.Outcome != "" {
+		if v.OutcomeSchema == nil {
+			return errors.New("verdict.outcome_schema is required with verdict.outcome")
+		}
+		if _, err := v.OutcomeSchema.Normalize(v.Outcome); err != nil {
+			return fmt.Errorf("verdict.outcome: %w", err)
+		}
+	}
+	for i, it := range v.Items {
+		if strings.TrimSpace(it.ItemID) == "" {
+			return fmt.Errorf("verdict.items[%d].item_id is required", i)
+		}
+		if err := it.Verdict.Validate(); err != nil {
+			return fmt.Errorf("verdict.items[%d].verdict: %w", i, err)
+		}
+		if it.Outcome != "" && it.OutcomeSchema == nil {
+			return fmt.Errorf("verdict.items[%d].outcome_schema is required with outcome", i)
+		}
+	}
 	if strings.TrimSpace(v.Reasoning) == "" {
 		return errors.New("verdict.reasoning is required")
 	}
@@ -60,3 +60,66 @@ func TestVerdictValidate(t *testing.T) {
 		t.Fatalf("expected valid verdict, got %v", err)
 	}
 }
+
+func TestOutcomeSchemaNormalize(t *testing.T) {
+	triage := OutcomeSchemaFor(Case{Type: "priority_triage"})
+	if triage == nil {
+		t.Fatal("expected built-in priority_triage schema")
+	}
+	if got, err := triage.Normalize("p1"); err != nil || got != "P1" {
+		t.Fatalf("expected P1, got %q (%v)", got, err)
+	}
+	if _, err := triage.Normalize("P9"); err == nil {
+		t.Fatal("expected error for unknown option")
+	}
+	gate := OutcomeSchemaFor(Case{Type: "gate_criteria"})
+	if got, err := gate.Normalize("70.0%"); err != nil || got != "70" {
+		t.Fatalf("expected 70, got %q (%v)", got, err)
+	}
+	if _, err := gate.Normalize("120"); err == nil {
+		t.Fatal("expected error above maximum")
+	}
+	if OutcomeSchemaFor(Case{Type: "general"}) != nil {
+		t.Fatal("expected classic decisions for untyped cases")
+	}
+}
+
+func TestCaseQuestionsNormalizeAndItem(t *testing.T) {
+	c := Case{
+		Summary: "Release plan",
+		Questions: []SubQuestion{
+			{Question: "Ship the cache layer?"},
+			{ID: "region", Question: "Which region first?", Options: []string{"eu", "us"}},
+		},
+	}
+	c.Normalize(time.Date(2026, 2, 20, 10, 0, 0, 0, time.UTC))
+	if err := c.Validate(); err != nil {
+		t.Fatalf("expected valid case, got %v", err)
+	}
+	if c.Questions[0].ID != "q1" || c.Question == "" {
+		t.Fatalf("expected generated item id and joined question, got %+v", c)
+	}
+	sub, ok := c.Item("region")
+	if !ok || sub.Question != "Which region first?" || len(sub.Options) != 2 || sub.ID != c.ID {
+		t.Fatalf("unexpected item case: %+v", sub)
+	}
+	c.Questions[1].ID = "q1"
+	if err := c.Validate(); err == nil {
+		t.Fatal("expected duplicate item id error")
+	}
+}
+
+func TestNewCaseIDIsUniqueAndTimeOrdered(t *testing.T) {
+	now := time.Date(2026, 2, 20, 10, 11, 12, 0, time.UTC)
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		id := NewCaseID(now)
+		if seen[id] {
+			t.Fatalf("duplicate case ID %s within one second", id)
+		}
+		seen[id] = true
+	}
+	if earlier, later := NewCaseID(now), NewCaseID(now.Add(time.Second)); earlier >= later {
+		t.Fatalf("expected %s to sort before %s", earlier, later)
+	}
+}
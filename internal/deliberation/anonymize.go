@@ -0,0 +1,111 @@
+package deliberation
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// Anonymize assigns each seat a shuffled "Senator A"-style label and a
+// shuffled presentation order, both derived from seed so a run can be replayed.
+func Anonymize(panel []core.PanelMember, seed int64) core.Anonymization {
+	rng := rand.New(rand.NewPCG(uint64(seed), 0))
+	a := core.Anonymization{Seed: seed, Labels: make(map[string]string, len(panel))}
+	for i, idx := range rng.Perm(len(panel)) {
+		a.Labels[panel[idx].AgentID] = anonymousLabel(i)
+	}
+	for _, idx := range rng.Perm(len(panel)) {
+		a.Order = append(a.Order, panel[idx].AgentID)
+	}
+	return a
+}
+
+// Sealed is a without its seat-to-label mapping, keeping only the seed. A
+// paused deliberation stores it sealed, so the voters who can read the
+// pending file cannot unmask the labels before the case is decided.
+func Sealed(a *core.Anonymization) *core.Anonymization {
+	if a == nil {
+		return nil
+	}
+	return &core.Anonymization{Seed: a.Seed}
+}
+
+// Unsealed rebuilds a sealed anonymization for panel from its seed; a full
+// one is returned as is.
+func Unsealed(a *core.Anonymization, panel []core.PanelMember) *core.Anonymization {
+	if a == nil || a.Labels != nil {
+		return a
+	}
+	full := Anonymize(panel, a.Seed)
+	return &full
+}
+
+func anonymousLabel(i int) string {
+	if i < 26 {
+		return "Senator " + string(rune('A'+i))
+	}
+	return fmt.Sprintf("Senator %d", i+1)
+}
+
+// SeatLabel is how other seats see agentID: its anonymous label, or the
+// agent id when positions are not anonymized.
+func SeatLabel(agentID string, a *core.Anonymization) string {
+	if a != nil {
+		if label, ok := a.Labels[agentID]; ok {
+			return label
+		}
+	}
+	return agentID
+}
+
+// PresentedChallenge is a challenge as shown to the seat it is put to.
+type PresentedChallenge struct {
+	From      string
+	Challenge string
+}
+
+// PresentChallenges prepares the challenges put to agentID, or to the whole
+// panel, for display to that seat, labelling their senders as SeatLabel does.
+func PresentChallenges(challenges []core.Challenge, agentID string, a *core.Anonymization) []PresentedChallenge {
+	var out []PresentedChallenge
+	for _, ch := range challenges {
+		if ch.To == agentID || ch.To == PanelAddressee {
+			out = append(out, PresentedChallenge{From: SeatLabel(ch.From, a), Challenge: ch.Challenge})
+		}
+	}
+	return out
+}
+
+// PresentedPosition is a position as shown to other seats.
+type PresentedPosition struct {
+	Label     string
+	Stance    core.Decision
+	Outcome   string
+	Reasoning string
+}
+
+// PresentPositions prepares positions for display to other seats. With an
+// anonymization, labels replace agent ids, perspectives and models, and
+// positions follow the shuffled order; otherwise panel order is kept and the
+// label names the seat and its perspective.
+func PresentPositions(positions []core.Position, a *core.Anonymization) []PresentedPosition {
+	out := make([]PresentedPosition, 0, len(positions))
+	if a == nil {
+		for _, p := range positions {
+			out = append(out, PresentedPosition{
+				Label:     fmt.Sprintf("%s (%s)", p.AgentID, p.Perspective),
+				Stance:    p.Stance,
+				Outcome:   p.Outcome,
+				Reasoning: p.Reasoning,
+			})
+		}
+		return out
+	}
+	for _, id := range a.Order {
+		if p, ok := findPosition(positions, id); ok {
+			out = append(out, PresentedPosition{Label: SeatLabel(id, a), Stance: p.Stance, Outcome: p.Outcome, Reasoning: p.Reasoning})
+		}
+	}
+	return out
+}
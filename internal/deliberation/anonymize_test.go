@@ -0,0 +1,101 @@
+package deliberation
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+func TestAnonymizeIsSeededAndComplete(t *testing.T) {
+	panel := PanelMembers(BuildPanel(5, nil, nil))
+	a := Anonymize(panel, 42)
+	if !reflect.DeepEqual(a, Anonymize(panel, 42)) {
+		t.Fatal("expected the same seed to reproduce the same anonymization")
+	}
+	if len(a.Labels) != 5 || len(a.Order) != 5 || a.Seed != 42 {
+		t.Fatalf("expected every seat labelled and ordered, got %+v", a)
+	}
+	seen := map[string]struct{}{}
+	for _, label := range a.Labels {
+		seen[label] = struct{}{}
+	}
+	if len(seen) != 5 {
+		t.Fatalf("expected unique labels, got %+v", a.Labels)
+	}
+}
+
+func TestPresentPositionsHidesSeatIdentity(t *testing.T) {
+	panel := PanelMembers(BuildPanel(3, nil, nil))
+	a := Anonymize(panel, 7)
+	positions := []core.Position{
+		{AgentID: panel[0].AgentID, Perspective: panel[0].Perspective, Model: panel[0].Model, Stance: core.DecisionApprove, Reasoning: "Fine."},
+		{AgentID: panel[1].AgentID, Perspective: panel[1].Perspective, Model: panel[1].Model, Stance: core.DecisionReject, Reasoning: "Risky."},
+		{AgentID: panel[2].AgentID, Perspective: panel[2].Perspective, Model: panel[2].Model, Stance: core.DecisionAmend, Reasoning: "Narrow it."},
+	}
+	shown := PresentPositions(positions, &a)
+	if len(shown) != 3 {
+		t.Fatalf("expected three presented positions, got %d", len(shown))
+	}
+	for i, p := range shown {
+		if p.Label != a.Labels[a.Order[i]] {
+			t.Fatalf("expected shuffled order with labels, got %+v", shown)
+		}
+		for _, m := range panel {
+			if strings.Contains(p.Label, m.Perspective) || strings.Contains(p.Label, m.AgentID) {
+				t.Fatalf("label %q leaks seat identity", p.Label)
+			}
+		}
+	}
+}
+
+type reviewRecorder struct {
+	*RuleAgent
+	reviews map[string]Review
+}
+
+func (a reviewRecorder) Review(c core.Case, p Perspective, r Review) Assessment {
+	a.reviews[p.Name] = r
+	return a.RuleAgent.Review(c, p, r)
+}
+
+func TestReviewingAgentsSeeAnonymizedPositions(t *testing.T) {
+	c := core.Case{ID: "senate-anon", Type: "architecture", Summary: "Drop the legacy queue", Question: "Should we drop the legacy queue without a migration?", FiledAt: "2026-03-01T12:00:00Z"}
+	for _, anonymize := range []bool{false, true} {
+		agent := reviewRecorder{RuleAgent: NewRuleAgent(nil), reviews: map[string]Review{}}
+		engine := New(BuildPanel(3, nil, nil))
+		engine.Agent = agent
+		engine.Anonymize = anonymize
+		engine.Seed = 3
+		transcript, _, err := engine.Deliberate(c, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(agent.reviews) != 3 {
+			t.Fatalf("expected every agent seat to review, got %d", len(agent.reviews))
+		}
+		for name, r := range agent.reviews {
+			if len(r.Positions) != 2 {
+				t.Fatalf("%s: expected the two other positions, got %+v", name, r.Positions)
+			}
+			var shown []string
+			for _, p := range r.Positions {
+				shown = append(shown, p.Label)
+			}
+			for _, ch := range r.Challenges {
+				shown = append(shown, ch.From)
+			}
+			for _, label := range shown {
+				leaks := false
+				for _, m := range transcript.Panel {
+					leaks = leaks || strings.Contains(label, m.AgentID)
+				}
+				if leaks == anonymize {
+					t.Fatalf("anonymize=%t: %s was shown %q", anonymize, name, label)
+				}
+			}
+		}
+	}
+}
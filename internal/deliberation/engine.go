@@ -9,10 +9,106 @@ import (
 	"github.com/Perttulands/senate/internal/core"
 )
 
+// Assessment is one seat's evaluation of a case.
+type Assessment struct {
+	Stance core.Decision
+	// Outcome is the seat's typed value for cases with an outcome schema.
+	Outcome string
+	// Ranking orders the options of a multi-option case, most preferred first.
+	Ranking   []string
+	Reasoning string
+	Concerns  string
+	// Motion requests more evidence from the filer before deciding.
+	Motion string
+}
+
+// Agent produces the initial assessment for a panel seat.
+type Agent interface {
+	Evaluate(c core.Case, p Perspective) Assessment
+}
+
+// ReviewingAgent is an Agent that settles its own final position after the
+// challenge round instead of taking the one the protocol proposes. It sees
+// the rest of the panel only as presented to other seats, so under
+// anonymization it never learns which seat holds which view.
+type ReviewingAgent interface {
+	Agent
+	Review(c core.Case, p Perspective, r Review) Assessment
+}
+
+// Review is what a seat is shown before its final position: its own initial
+// position, the final position the protocol proposes for it, the other
+// seats' initial positions and the challenges put to it.
+type Review struct {
+	Initial    Assessment
+	Proposed   Assessment
+	Positions  []PresentedPosition
+	Challenges []PresentedChallenge
+}
+
+// Ballots supplies positions for human seats, recorded outside the engine.
+// Item is the sub-question ID for multi-question cases and empty otherwise.
+type Ballots interface {
+	Ballot(seat core.PanelMember, round, item string) (Assessment, bool)
+}
+
+// AwaitingVotesError reports a deliberation paused until human seats vote.
+// Transcript holds every position collected so far and can be passed back
+// as Engine.Prior to resume.
+type AwaitingVotesError struct {
+	Round string
+	// Item is the sub-question awaiting votes in a multi-question case.
+	Item       string
+	Seats      []core.PanelMember
+	Transcript core.Transcript
+}
+
+// MotionError reports a deliberation paused by a carried evidence motion.
+// The motion is already appended to Transcript.Motions; the deliberation
+// resumes, with fresh positions, once the filer amends the case.
+type MotionError struct {
+	Motion     core.EvidenceMotion
+	Transcript core.Transcript
+}
+
+func (e *MotionError) Error() string {
+	return fmt.Sprintf("panel moved for more evidence (%d request(s))", len(e.Motion.Requests))
+}
+
+func (e *AwaitingVotesError) Error() string {
+	if e.Item != "" {
+		return fmt.Sprintf("awaiting %d human vote(s) for %s round of %s", len(e.Seats), e.Round, e.Item)
+	}
+	return fmt.Sprintf("awaiting %d human vote(s) for %s round", len(e.Seats), e.Round)
+}
+
 // Engine runs the Senate deliberation protocol.
 type Engine struct {
 	Panel      []Perspective
 	JudgeModel string
+	Agent      Agent
+	Ballots    Ballots
+	Vetoes     []Veto
+	// Protocol names the registered deliberation protocol; empty means senate.
+	Protocol string
+	// Anonymize presents positions to other seats under shuffled labels in a
+	// shuffled order derived from Seed.
+	Anonymize bool
+	Seed      int64
+	// Tools are offered to seats whose agent implements ToolAgent, each seat
+	// limited to ToolBudget calls per case (DefaultToolBudget when zero).
+	Tools      []Tool
+	ToolBudget int
+	toolUse    map[string]*int
+	toolLog    []core.ToolCall
+	// anon is the running deliberation's anonymization, nil without one.
+	anon *core.Anonymization
+	// Motions lets a majority of the panel send the case back to its filer
+	// for more evidence instead of deciding.
+	Motions bool
+	// Prior is a partial transcript from a paused deliberation; positions it
+	// already holds are kept as-is.
+	Prior *core.Transcript
 }
 
 func New(panel []Perspective) *Engine {
@@ -22,93 +118,313 @@ func New(panel []Perspective) *Engine {
 	return &Engine{
 		Panel:      panel,
 		JudgeModel: "claude:opus",
+		Agent:      NewRuleAgent(nil),
 	}
 }
 
 // Deliberate executes initial position, challenge, final position, and verdict synthesis.
+// Multi-question cases run the protocol once per sub-question, in order.
+// When a human seat has not voted it returns the partial transcript with an *AwaitingVotesError.
 func (e *Engine) Deliberate(c core.Case, now time.Time) (core.Transcript, core.Verdict, error) {
 	if err := c.Validate(); err != nil {
 		return core.Transcript{}, core.Verdict{}, err
 	}
+	if err := ValidateProtocol(e.Protocol); err != nil {
+		return core.Transcript{}, core.Verdict{}, err
+	}
+	e.toolUse = nil
 	started := now.UTC()
-	panelMembers := toPanelMembers(e.Panel)
-
-	initial := make([]core.Position, 0, len(panelMembers))
-	for i, seat := range panelMembers {
-		p := e.Panel[i]
-		stance, reason, concerns := evaluateInitial(c, p)
-		initial = append(initial, core.Position{
-			AgentID:     seat.AgentID,
-			Model:       seat.Model,
-			Perspective: seat.Perspective,
-			Round:       "initial",
-			Stance:      stance,
-			Reasoning:   reason,
-			Concerns:    concerns,
-		})
+	if e.Prior != nil {
+		if t, err := time.Parse(time.RFC3339, e.Prior.StartedAt); err == nil {
+			started = t
+		}
+	}
+	transcript := core.Transcript{
+		CaseID:     c.ID,
+		StartedAt:  started.Format(time.RFC3339),
+		Panel:      PanelMembers(e.Panel),
+		JudgeModel: e.JudgeModel,
+		Protocol:   e.Protocol,
+	}
+	if e.Prior != nil {
+		transcript.Motions = e.Prior.Motions
+	}
+	transcript.Recusals = Recusals(c, transcript.Panel)
+	switch {
+	case e.Prior != nil && e.Prior.Anonymization != nil:
+		transcript.Anonymization = Unsealed(e.Prior.Anonymization, transcript.Panel)
+	case e.Anonymize:
+		a := Anonymize(transcript.Panel, e.Seed)
+		transcript.Anonymization = &a
+	}
+	e.anon = transcript.Anonymization
+	completed := started.Add(2 * time.Minute)
+	if now.UTC().After(completed) {
+		completed = now.UTC()
 	}
 
-	challenges := buildChallenges(c, initial)
-	final := finalizePositions(c, initial, challenges)
-	verdict := synthesizeVerdict(c, final, e.JudgeModel, started.Add(2*time.Minute))
+	if len(c.Questions) > 0 {
+		return e.deliberateItems(c, transcript, now, completed)
+	}
 
-	transcript := core.Transcript{
-		CaseID:           c.ID,
-		StartedAt:        started.Format(time.RFC3339),
-		CompletedAt:      started.Add(2 * time.Minute).Format(time.RFC3339),
-		Panel:            panelMembers,
-		InitialPositions: initial,
-		Challenges:       challenges,
-		FinalPositions:   final,
-		JudgeModel:       e.JudgeModel,
+	var prior core.ItemTranscript
+	if e.Prior != nil {
+		prior = core.ItemTranscript{InitialPositions: e.Prior.InitialPositions, FinalPositions: e.Prior.FinalPositions, ToolCalls: e.Prior.ToolCalls}
+	}
+	rec, round, waiting := e.runRounds(c, "", prior)
+	if m, ok := e.motion(prior, rec, round, len(transcript.Motions), now); ok {
+		transcript.InitialPositions = rec.InitialPositions
+		transcript.ToolCalls = rec.ToolCalls
+		transcript.Motions = append(transcript.Motions, m)
+		return transcript, core.Verdict{}, &MotionError{Motion: m, Transcript: transcript}
 	}
+	transcript.ToolCalls = rec.ToolCalls
+	transcript.InitialPositions = rec.InitialPositions
+	transcript.Challenges = rec.Challenges
+	transcript.FinalPositions = rec.FinalPositions
+	if len(waiting) > 0 {
+		return transcript, core.Verdict{}, &AwaitingVotesError{Round: round, Seats: waiting, Transcript: transcript}
+	}
+
+	verdict := e.decide(c, &rec, completed)
+	transcript.Quorum = rec.Quorum
+	transcript.Vetoes = rec.Vetoes
+	transcript.Ballot = rec.Ballot
+	transcript.Metrics = rec.Metrics
+	transcript.CompletedAt = completed.Format(time.RFC3339)
 	return transcript, verdict, nil
 }
 
-func evaluateInitial(c core.Case, p Perspective) (core.Decision, string, string) {
-	risk := tokenScore(c.Question+" "+c.Summary, []string{"security", "unsafe", "drop", "delete", "disable", "bypass", "without tests", "rollback"})
-	urgency := tokenScore(c.Question+" "+c.Summary, []string{"urgent", "blocker", "ship", "today", "immediately", "unblock"})
-	evidenceWeight := len(c.Evidence)
+// deliberateItems decides each sub-question independently and compounds the
+// item verdicts: a unanimous item decision carries over, anything mixed is
+// amended, and the verdict binds only when every item binds. Items keep their
+// own binding, so a partly binding verdict still hands off its binding items.
+func (e *Engine) deliberateItems(c core.Case, transcript core.Transcript, now, completed time.Time) (core.Transcript, core.Verdict, error) {
+	subs := make([]core.Case, 0, len(c.Questions))
+	for _, q := range c.Questions {
+		sub, _ := c.Item(q.ID)
+		var prior core.ItemTranscript
+		if e.Prior != nil {
+			for _, it := range e.Prior.Items {
+				if it.ItemID == q.ID {
+					prior = it
+				}
+			}
+		}
+		rec, round, waiting := e.runRounds(sub, q.ID, prior)
+		rec.ItemID = q.ID
+		rec.Question = q.Question
+		if m, ok := e.motion(prior, rec, round, len(transcript.Motions), now); ok {
+			rec.Challenges, rec.FinalPositions = nil, nil
+			transcript.Items = append(transcript.Items, rec)
+			transcript.Motions = append(transcript.Motions, m)
+			return transcript, core.Verdict{}, &MotionError{Motion: m, Transcript: transcript}
+		}
+		transcript.Items = append(transcript.Items, rec)
+		if len(waiting) > 0 {
+			return transcript, core.Verdict{}, &AwaitingVotesError{Round: round, Item: q.ID, Seats: waiting, Transcript: transcript}
+		}
+		subs = append(subs, sub)
+	}
 
-	switch p.Name {
-	case "pragmatist":
-		if risk >= 2 {
-			return core.DecisionReject, "The change introduces high risk compared to delivery value.", "Risk reduction plan is missing."
+	verdict := core.Verdict{
+		CaseID:    c.ID,
+		FiledAt:   c.FiledAt,
+		VerdictAt: completed.UTC().Format(time.RFC3339),
+		Type:      c.Type,
+		Summary:   c.Summary,
+		Judge:     e.JudgeModel,
+	}
+	var reasons, steps, dissent []string
+	var metrics []core.Metrics
+	for i, sub := range subs {
+		rec := &transcript.Items[i]
+		v := e.decide(sub, rec, completed)
+		metrics = append(metrics, *rec.Metrics)
+		verdict.Items = append(verdict.Items, core.ItemVerdict{
+			ItemID:         rec.ItemID,
+			Question:       rec.Question,
+			Verdict:        v.Verdict,
+			Outcome:        v.Outcome,
+			OutcomeSchema:  v.OutcomeSchema,
+			Reasoning:      v.Reasoning,
+			Implementation: v.Implementation,
+			Dissent:        v.Dissent,
+			Binding:        v.Binding,
+			FinalPositions: v.FinalPositions,
+		})
+		if i == 0 || verdict.Verdict == v.Verdict {
+			verdict.Verdict = v.Verdict
+		} else {
+			verdict.Verdict = core.DecisionAmend
+		}
+		verdict.Binding = (i == 0 || verdict.Binding) && v.Binding
+		reasons = append(reasons, fmt.Sprintf("%s %s: %s", rec.ItemID, v.Verdict, v.Reasoning))
+		steps = append(steps, fmt.Sprintf("%s: %s", rec.ItemID, v.Implementation))
+		if v.Dissent != "" {
+			dissent = append(dissent, fmt.Sprintf("%s: %s", rec.ItemID, v.Dissent))
 		}
-		if urgency >= 1 || evidenceWeight >= 2 {
-			return core.DecisionApprove, "The path is actionable now and clears immediate delivery constraints.", "Document rollback and ownership."
+	}
+	verdict.Reasoning = strings.Join(reasons, " ")
+	verdict.Implementation = strings.Join(steps, " ")
+	verdict.Dissent = strings.Join(dissent, " | ")
+	mean := MeanMetrics(metrics)
+	transcript.Metrics = &mean
+	transcript.CompletedAt = completed.Format(time.RFC3339)
+	return transcript, verdict, nil
+}
+
+// runRounds collects one question's rounds with the engine's protocol,
+// reusing positions already in prior. It stops at the first round with human
+// seats still to vote and reports that round and the waiting seats.
+func (e *Engine) runRounds(c core.Case, item string, prior core.ItemTranscript) (core.ItemTranscript, string, []core.PanelMember) {
+	run, ok := protocols[e.Protocol]
+	if !ok {
+		run = protocols[ProtocolSenate]
+	}
+	e.toolLog = append([]core.ToolCall(nil), prior.ToolCalls...)
+	rec, round, waiting := run(e, c, item, prior)
+	rec.ToolCalls = e.toolLog
+	e.toolLog = nil
+	return rec, round, waiting
+}
+
+// initialRound collects independent first positions from every seat.
+func (e *Engine) initialRound(c core.Case, item string, prior []core.Position) ([]core.Position, []core.PanelMember) {
+	agent := e.Agent
+	if agent == nil {
+		agent = NewRuleAgent(nil)
+	}
+	seats := PanelMembers(e.Panel)
+	toolAgent, useTools := agent.(ToolAgent)
+	useTools = useTools && len(e.Tools) > 0
+	return e.collectRound(core.RoundInitial, item, seats, recusedSeats(c, seats), prior, func(i int) Assessment {
+		if useTools {
+			return toolAgent.EvaluateWithTools(c, e.Panel[i], e.toolSession(c, seats[i], core.RoundInitial, &e.toolLog))
 		}
-		return core.DecisionAmend, "Direction is viable but needs tighter scope before execution.", "Define measurable acceptance criteria."
-	case "purist":
-		if risk >= 1 {
-			return core.DecisionReject, "Correctness and safety guarantees are not strong enough for approval.", "Failure modes are under-specified."
+		return agent.Evaluate(c, e.Panel[i])
+	})
+}
+
+// finalRound collects final positions, proposing the given positions for agent
+// seats. A ReviewingAgent is shown rec's initial positions and challenges,
+// presented under the deliberation's anonymization, and settles its own.
+func (e *Engine) finalRound(c core.Case, item string, rec core.ItemTranscript, proposed, prior []core.Position) ([]core.Position, []core.PanelMember) {
+	seats := PanelMembers(e.Panel)
+	agent := e.Agent
+	if agent == nil {
+		agent = NewRuleAgent(nil)
+	}
+	reviewer, reviews := agent.(ReviewingAgent)
+	return e.collectRound(core.RoundFinal, item, seats, recusedSeats(c, seats), prior, func(i int) Assessment {
+		p, _ := findPosition(proposed, seats[i].AgentID)
+		out := assessmentOf(p)
+		if !reviews {
+			return out
 		}
-		if evidenceWeight == 0 {
-			return core.DecisionDefer, "There is not enough evidence to make a durable decision.", "Need concrete examples or data."
+		own, _ := findPosition(rec.InitialPositions, seats[i].AgentID)
+		others := make([]core.Position, 0, len(rec.InitialPositions))
+		for _, pos := range rec.InitialPositions {
+			if pos.AgentID != seats[i].AgentID {
+				others = append(others, pos)
+			}
 		}
-		return core.DecisionAmend, "The proposal is directionally sound but requires stronger invariants.", "Specify exact rule boundaries."
-	case "skeptic":
-		if evidenceWeight == 0 {
-			return core.DecisionDefer, "The case lacks objective evidence and should not be bound yet.", "Gather incidents, diffs, or metrics first."
+		return reviewer.Review(c, e.Panel[i], Review{
+			Initial:    assessmentOf(own),
+			Proposed:   out,
+			Positions:  PresentPositions(others, e.anon),
+			Challenges: PresentChallenges(rec.Challenges, seats[i].AgentID, e.anon),
+		})
+	})
+}
+
+func assessmentOf(p core.Position) Assessment {
+	return Assessment{Stance: p.Stance, Outcome: p.Outcome, Ranking: p.Ranking, Reasoning: p.Reasoning, Concerns: p.Concerns}
+}
+
+// decide synthesizes the verdict for one question's completed rounds and
+// records its quorum, vetoes, ballot tally and metrics on rec. Without a
+// quorum of non-abstaining eligible seats the verdict defers.
+func (e *Engine) decide(c core.Case, rec *core.ItemTranscript, verdictAt time.Time) core.Verdict {
+	verdict := synthesizeVerdict(c, rec.FinalPositions, e.JudgeModel, verdictAt)
+	seats := PanelMembers(e.Panel)
+	quorum := countQuorum(seats, len(recusedSeats(c, seats)), rec.FinalPositions)
+	rec.Quorum = &quorum
+	if !quorum.Met {
+		verdict.Verdict = core.DecisionDefer
+		verdict.Binding = false
+		verdict.Implementation = buildImplementationText(c, core.DecisionDefer)
+		verdict.Reasoning = strings.TrimSpace(fmt.Sprintf("%s Quorum not met: %d of %d eligible seats voted, %d required.", verdict.Reasoning, quorum.Voting, quorum.Eligible, quorum.Required))
+	}
+	verdict, rec.Vetoes = applyVetoes(c, verdict, e.Vetoes)
+	verdict, rec.Ballot = settleOutcome(c, verdict)
+	metrics := ComputeMetrics(c, core.Transcript{
+		InitialPositions: rec.InitialPositions,
+		Challenges:       rec.Challenges,
+		FinalPositions:   rec.FinalPositions,
+	})
+	rec.Metrics = &metrics
+	return verdict
+}
+
+// collectRound gathers one round of positions in panel order, skipping recused
+// seats. Positions already in prior are reused; human seats without a ballot
+// are returned as waiting.
+func (e *Engine) collectRound(round, item string, seats []core.PanelMember, recused map[string]struct{}, prior []core.Position, evaluate func(i int) Assessment) ([]core.Position, []core.PanelMember) {
+	positions := make([]core.Position, 0, len(seats))
+	var waiting []core.PanelMember
+	for i, seat := range seats {
+		if _, ok := recused[seat.AgentID]; ok {
+			continue
 		}
-		if risk >= 1 {
-			return core.DecisionReject, "Edge-case risk remains unresolved under realistic failure scenarios.", "Mitigations are implied but not explicit."
+		if p, ok := findPosition(prior, seat.AgentID); ok {
+			positions = append(positions, p)
+			continue
 		}
-		return core.DecisionAmend, "Adopt with guardrails to contain unknowns.", "Time-box follow-up validation."
-	default:
-		if risk >= 2 {
-			return core.DecisionReject, "Risk exceeds confidence in current plan.", "Need safer rollout shape."
+		var a Assessment
+		if seat.Kind == core.SeatHuman {
+			ok := false
+			if e.Ballots != nil {
+				a, ok = e.Ballots.Ballot(seat, round, item)
+			}
+			if !ok {
+				waiting = append(waiting, seat)
+				continue
+			}
+		} else {
+			a = evaluate(i)
 		}
-		if evidenceWeight >= 1 {
-			return core.DecisionAmend, "Proceed with modifications grounded in the provided evidence.", "Capture precedent terms explicitly."
+		positions = append(positions, core.Position{
+			AgentID:     seat.AgentID,
+			Model:       seat.Model,
+			Perspective: seat.Perspective,
+			Round:       round,
+			Stance:      a.Stance,
+			Outcome:     a.Outcome,
+			Ranking:     a.Ranking,
+			Reasoning:   a.Reasoning,
+			Concerns:    a.Concerns,
+			Motion:      a.Motion,
+		})
+	}
+	return positions, waiting
+}
+
+func findPosition(positions []core.Position, agentID string) (core.Position, bool) {
+	for _, p := range positions {
+		if p.AgentID == agentID {
+			return p, true
 		}
-		return core.DecisionDefer, "Insufficient evidence for a binding conclusion.", "Collect at least one concrete artifact."
 	}
+	return core.Position{}, false
 }
 
 func buildChallenges(c core.Case, initial []core.Position) []core.Challenge {
 	challenges := make([]core.Challenge, 0, len(initial))
 	for _, pos := range initial {
+		if pos.Stance == core.DecisionAbstain {
+			continue
+		}
 		target := strongestCounter(pos.Stance, initial)
 		if target.AgentID == "" {
 			continue
@@ -125,7 +441,7 @@ func buildChallenges(c core.Case, initial []core.Position) []core.Challenge {
 
 func strongestCounter(stance core.Decision, positions []core.Position) core.Position {
 	for _, p := range positions {
-		if p.Stance != stance {
+		if p.Stance != stance && p.Stance != core.DecisionAbstain {
 			return p
 		}
 	}
@@ -139,7 +455,7 @@ func finalizePositions(c core.Case, initial []core.Position, challenges []core.C
 
 	for _, p := range initial {
 		out := p
-		out.Round = "final"
+		out.Round = core.RoundFinal
 		if majority != "" && p.Stance != majority {
 			if p.Stance == core.DecisionApprove && (majority == core.DecisionReject || majority == core.DecisionDefer) {
 				out.Stance = core.DecisionAmend
@@ -176,7 +492,7 @@ func synthesizeVerdict(c core.Case, final []core.Position, judge string, verdict
 	for _, p := range final {
 		if p.Stance == decision {
 			majorityReasons = append(majorityReasons, p.Reasoning)
-		} else {
+		} else if p.Stance != core.DecisionAbstain {
 			minorityReasons = append(minorityReasons, fmt.Sprintf("%s: %s", p.AgentID, p.Reasoning))
 		}
 	}
@@ -220,6 +536,8 @@ func buildImplementationText(c core.Case, decision core.Decision) string {
 	}
 }
 
+// countDecisions tallies stances per decision. Abstentions are not a
+// decision and are never counted, so they cannot become a modal stance.
 func countDecisions(positions []core.Position) map[core.Decision]int {
 	counts := map[core.Decision]int{
 		core.DecisionApprove: 0,
@@ -228,6 +546,9 @@ func countDecisions(positions []core.Position) map[core.Decision]int {
 		core.DecisionDefer:   0,
 	}
 	for _, p := range positions {
+		if p.Stance == core.DecisionAbstain {
+			continue
+		}
 		counts[p.Stance]++
 	}
 	return counts
@@ -256,17 +577,6 @@ func majorityDecision(counts map[core.Decision]int) core.Decision {
 	return ordered[0].decision
 }
 
-func tokenScore(text string, tokens []string) int {
-	text = strings.ToLower(text)
-	score := 0
-	for _, tok := range tokens {
-		if strings.Contains(text, tok) {
-			score++
-		}
-	}
-	return score
-}
-
 func uniqueFirstN(items []string, n int) []string {
 	if n <= 0 {
 		return nil
@@ -1,6 +1,7 @@
 package deliberation
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -59,3 +60,208 @@ func TestMajorityDecisionTieFallsBackToDefer(t *testing.T) {
 		t.Fatalf("expected deferred tie-break, got %s", got)
 	}
 }
+
+type mapBallots map[string]Assessment
+
+func (m mapBallots) Ballot(seat core.PanelMember, round, item string) (Assessment, bool) {
+	key := seat.AgentID + "/" + round
+	if item != "" {
+		key = seat.AgentID + "/" + item + "/" + round
+	}
+	a, ok := m[key]
+	return a, ok
+}
+
+func TestDeliberatePausesForHumanSeatAndResumes(t *testing.T) {
+	panel := append(BuildPanel(2, nil, nil), HumanSeats([]string{"alice"})...)
+	c := core.Case{
+		ID:       "senate-002",
+		Type:     "general",
+		Summary:  "Adopt new lint rule",
+		Question: "Should we adopt the lint rule?",
+		Evidence: []string{"lint report"},
+		FiledAt:  time.Now().UTC().Format(time.RFC3339),
+	}
+	engine := New(panel)
+	_, _, err := engine.Deliberate(c, time.Now().UTC())
+	var awaiting *AwaitingVotesError
+	if !errors.As(err, &awaiting) {
+		t.Fatalf("expected awaiting votes error, got %v", err)
+	}
+	if awaiting.Round != core.RoundInitial || len(awaiting.Seats) != 1 || awaiting.Seats[0].AgentID != "human-3" {
+		t.Fatalf("unexpected pause: round=%s seats=%+v", awaiting.Round, awaiting.Seats)
+	}
+	if len(awaiting.Transcript.InitialPositions) != 2 {
+		t.Fatalf("expected agent positions in partial transcript, got %d", len(awaiting.Transcript.InitialPositions))
+	}
+
+	resumed := New(PanelFromMembers(awaiting.Transcript.Panel))
+	resumed.Prior = &awaiting.Transcript
+	resumed.Ballots = mapBallots{
+		"human-3/initial": {Stance: core.DecisionApprove, Reasoning: "Looks good."},
+		"human-3/final":   {Stance: core.DecisionAmend, Reasoning: "Scope it down."},
+	}
+	transcript, verdict, err := resumed.Deliberate(c, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	if len(transcript.FinalPositions) != 3 || transcript.FinalPositions[2].Stance != core.DecisionAmend {
+		t.Fatalf("expected human final vote recorded, got %+v", transcript.FinalPositions)
+	}
+	if transcript.Panel[2].Kind != core.SeatHuman {
+		t.Fatalf("expected human seat kind, got %q", transcript.Panel[2].Kind)
+	}
+	if err := verdict.Validate(); err != nil {
+		t.Fatalf("verdict validation failed: %v", err)
+	}
+}
+
+func TestDeliberateDecidesEachQuestion(t *testing.T) {
+	c := core.Case{
+		ID:      "senate-005",
+		Type:    "general",
+		Summary: "Release plan",
+		Questions: []core.SubQuestion{
+			{Question: "Should we ship the cache layer with staged rollout and test evidence?"},
+			{Question: "Which region goes first?", Options: []string{"eu-west", "us-east"}},
+		},
+		Evidence: []string{"load test"},
+		FiledAt:  time.Now().UTC().Format(time.RFC3339),
+	}
+	c.Normalize(time.Now().UTC())
+	panel := append(BuildPanel(2, nil, nil), HumanSeats([]string{"alice"})...)
+	_, _, err := New(panel).Deliberate(c, time.Now().UTC())
+	var awaiting *AwaitingVotesError
+	if !errors.As(err, &awaiting) || awaiting.Item != "q1" || awaiting.Round != core.RoundInitial {
+		t.Fatalf("expected pause on q1 initial round, got %v", err)
+	}
+
+	resumed := New(PanelFromMembers(awaiting.Transcript.Panel))
+	resumed.Prior = &awaiting.Transcript
+	resumed.Ballots = mapBallots{
+		"human-3/q1/initial": {Stance: core.DecisionApprove, Reasoning: "Ship it."},
+		"human-3/q1/final":   {Stance: core.DecisionApprove, Reasoning: "Ship it."},
+		"human-3/q2/initial": {Stance: core.DecisionApprove, Outcome: "us-east", Ranking: []string{"us-east", "eu-west"}, Reasoning: "Most traffic."},
+		"human-3/q2/final":   {Stance: core.DecisionApprove, Outcome: "us-east", Ranking: []string{"us-east", "eu-west"}, Reasoning: "Most traffic."},
+	}
+	transcript, verdict, err := resumed.Deliberate(c, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	if len(transcript.Items) != 2 || len(verdict.Items) != 2 || transcript.Metrics == nil {
+		t.Fatalf("expected two decided items, got %d transcripts, %d verdicts", len(transcript.Items), len(verdict.Items))
+	}
+	if verdict.Items[1].Outcome == "" || transcript.Items[1].Ballot == nil {
+		t.Fatalf("expected ranked outcome on q2, got %+v", verdict.Items[1])
+	}
+	item, ok := verdict.Item("q2")
+	if !ok || item.CaseID != "senate-005#q2" {
+		t.Fatalf("expected projected item verdict, got %+v", item)
+	}
+	if err := verdict.Validate(); err != nil {
+		t.Fatalf("verdict validation failed: %v", err)
+	}
+}
+
+func TestMultiQuestionVerdictBindsOnlyWhenEveryItemBinds(t *testing.T) {
+	c := core.Case{
+		ID:      "senate-006",
+		Type:    "general",
+		Summary: "Release plan",
+		Questions: []core.SubQuestion{
+			{Question: "Should we ship the cache layer?"},
+			{Question: "Should we retire the old cache?"},
+		},
+		FiledAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	c.Normalize(time.Now().UTC())
+	e := New(HumanSeats([]string{"alice"}))
+	e.Ballots = mapBallots{
+		"human-1/q1/initial": {Stance: core.DecisionApprove, Reasoning: "Ship it."},
+		"human-1/q1/final":   {Stance: core.DecisionApprove, Reasoning: "Ship it."},
+		"human-1/q2/initial": {Stance: core.DecisionDefer, Reasoning: "Not yet."},
+		"human-1/q2/final":   {Stance: core.DecisionDefer, Reasoning: "Not yet."},
+	}
+	_, verdict, err := e.Deliberate(c, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("deliberate: %v", err)
+	}
+	if len(verdict.Items) != 2 || !verdict.Items[0].Binding || verdict.Items[1].Binding {
+		t.Fatalf("expected a binding q1 and a non-binding q2, got %+v", verdict.Items)
+	}
+	if verdict.Verdict != core.DecisionAmend || verdict.Binding {
+		t.Fatalf("expected a non-binding amended verdict, got %s binding=%v", verdict.Verdict, verdict.Binding)
+	}
+}
+
+func TestDeliberateCarriesTypedOutcome(t *testing.T) {
+	c := core.Case{
+		ID:                "senate-003",
+		Type:              "gate_criteria",
+		Summary:           "Coverage gate for new code",
+		Question:          "Should Centurion require coverage on new code?",
+		RequestedDecision: "Require 70% line coverage on new code",
+		Evidence:          []string{"coverage report", "incident log"},
+		FiledAt:           time.Now().UTC().Format(time.RFC3339),
+	}
+	_, verdict, err := New(BuildPanel(5, nil, nil)).Deliberate(c, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("deliberate: %v", err)
+	}
+	if verdict.OutcomeSchema == nil || verdict.Outcome != "70" {
+		t.Fatalf("expected outcome 70 with schema, got %q", verdict.Outcome)
+	}
+	if err := verdict.Validate(); err != nil {
+		t.Fatalf("verdict validation failed: %v", err)
+	}
+}
+
+func TestBuiltInVocabularyKeepsClassicVerdictBinding(t *testing.T) {
+	c := core.Case{
+		ID:       "senate-004",
+		Type:     "gate_criteria",
+		Summary:  "Coverage gate for new code",
+		Question: "Should Centurion require coverage on new code?",
+		Evidence: []string{"coverage report", "incident log"},
+		FiledAt:  time.Now().UTC().Format(time.RFC3339),
+	}
+	_, verdict, err := New(BuildPanel(5, nil, nil)).Deliberate(c, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("deliberate: %v", err)
+	}
+	if verdict.Verdict != core.DecisionAmend || !verdict.Binding || verdict.Outcome != "" {
+		t.Fatalf("expected a binding amendment without outcome, got %s binding=%v outcome=%q", verdict.Verdict, verdict.Binding, verdict.Outcome)
+	}
+
+	number := core.OutcomeSchema{Kind: core.OutcomeNumber, Unit: "%"}
+	c.OutcomeSchema = &number
+	_, verdict, err = New(BuildPanel(5, nil, nil)).Deliberate(c, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("deliberate: %v", err)
+	}
+	if verdict.Verdict != core.DecisionDefer || verdict.Binding {
+		t.Fatalf("expected a declared schema without outcome to defer, got %s binding=%v", verdict.Verdict, verdict.Binding)
+	}
+}
+
+func TestAggregateOutcome(t *testing.T) {
+	enum := core.OutcomeSchema{Kind: core.OutcomeEnum, Options: []string{"P0", "P1", "P2"}}
+	positions := []core.Position{
+		{Stance: core.DecisionApprove, Outcome: "P2"},
+		{Stance: core.DecisionApprove, Outcome: "P1"},
+		{Stance: core.DecisionDefer, Outcome: "P2"},
+	}
+	if got := aggregateOutcome(enum, positions); got != "P1" {
+		t.Fatalf("expected tie to go to earlier option P1, got %q", got)
+	}
+	number := core.OutcomeSchema{Kind: core.OutcomeNumber}
+	positions = []core.Position{
+		{Stance: core.DecisionApprove, Outcome: "60"},
+		{Stance: core.DecisionAmend, Outcome: "80"},
+		{Stance: core.DecisionApprove, Outcome: "75"},
+		{Stance: core.DecisionReject, Outcome: "90"},
+	}
+	if got := aggregateOutcome(number, positions); got != "77.5" {
+		t.Fatalf("expected median 77.5, got %q", got)
+	}
+}
@@ -0,0 +1,107 @@
+package deliberation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// DefaultEnsembleThreshold is the run agreement below which an ensemble
+// verdict is made non-binding.
+const DefaultEnsembleThreshold = 2.0 / 3.0
+
+// DeliberateEnsemble runs k independent panels, each with the seat order
+// rotated and the seed offset by its run index, and aggregates their verdicts.
+// The final verdict is the runs' majority (ties defer); its transcript is the
+// first run reaching it. Typed outcomes aggregate over the agreeing runs as
+// for seats. When agreement falls below threshold the verdict is non-binding.
+// The built-in RuleAgent ignores seat order and seed, so its runs always agree;
+// ensembles are meant for agents whose answers vary between runs.
+func (e *Engine) DeliberateEnsemble(c core.Case, now time.Time, k int, threshold float64) (core.Transcript, core.Verdict, error) {
+	if k < 1 {
+		return core.Transcript{}, core.Verdict{}, fmt.Errorf("ensemble size must be at least 1, got %d", k)
+	}
+	for _, p := range e.Panel {
+		if p.Human {
+			return core.Transcript{}, core.Verdict{}, errors.New("ensemble deliberation cannot include human seats")
+		}
+	}
+	if e.Motions {
+		return core.Transcript{}, core.Verdict{}, errors.New("ensemble deliberation cannot raise evidence motions")
+	}
+	if threshold <= 0 {
+		threshold = DefaultEnsembleThreshold
+	}
+
+	transcripts := make([]core.Transcript, 0, k)
+	verdicts := make([]core.Verdict, 0, k)
+	summary := core.EnsembleSummary{Size: k, Threshold: threshold, Verdicts: map[core.Decision]int{}}
+	for i := 0; i < k; i++ {
+		run := *e
+		run.Panel = rotatePanel(e.Panel, i)
+		run.Seed = e.Seed + int64(i)
+		t, v, err := run.Deliberate(c, now)
+		if err != nil {
+			return core.Transcript{}, core.Verdict{}, fmt.Errorf("ensemble run %d: %w", i+1, err)
+		}
+		transcripts = append(transcripts, t)
+		verdicts = append(verdicts, v)
+		summary.Verdicts[v.Verdict]++
+		summary.Runs = append(summary.Runs, core.EnsembleRun{Run: i + 1, Seed: run.Seed, Verdict: v.Verdict, Outcome: v.Outcome})
+		if v.Outcome != "" {
+			if summary.Outcomes == nil {
+				summary.Outcomes = map[string]int{}
+			}
+			summary.Outcomes[v.Outcome]++
+		}
+	}
+
+	decision := majorityDecision(summary.Verdicts)
+	if decision == "" {
+		decision = core.DecisionDefer
+	}
+	summary.Agreement = ratio(summary.Verdicts[decision], k)
+
+	pick := -1
+	var agreeing []core.Position
+	for i, v := range verdicts {
+		if v.Verdict != decision {
+			continue
+		}
+		if pick < 0 {
+			pick = i
+		}
+		agreeing = append(agreeing, core.Position{Stance: v.Verdict, Outcome: v.Outcome})
+	}
+	var transcript core.Transcript
+	var verdict core.Verdict
+	if pick >= 0 {
+		transcript, verdict = transcripts[pick], verdicts[pick]
+		if verdict.OutcomeSchema != nil && len(c.Options) == 0 {
+			if outcome := aggregateOutcome(*verdict.OutcomeSchema, agreeing); outcome != "" && outcome != verdict.Outcome {
+				unit := schemaUnit(*verdict.OutcomeSchema)
+				verdict.Implementation = strings.Replace(verdict.Implementation, "Outcome: "+verdict.Outcome+unit+".", "Outcome: "+outcome+unit+".", 1)
+				verdict.Outcome = outcome
+			}
+		}
+	} else {
+		// A tie between runs defers on the first run's record.
+		transcript, verdict = transcripts[0], verdicts[0]
+		verdict.Verdict = core.DecisionDefer
+		verdict.Outcome = ""
+		verdict.Binding = false
+		verdict.Implementation = buildImplementationText(c, core.DecisionDefer)
+	}
+
+	note := fmt.Sprintf("Ensemble of %d runs: %d reached %s (agreement %.2f).", k, summary.Verdicts[decision], decision, summary.Agreement)
+	if summary.Agreement < threshold {
+		verdict.Binding = false
+		note += fmt.Sprintf(" Below the %.2f agreement threshold; verdict is non-binding.", threshold)
+	}
+	verdict.Reasoning = strings.TrimSpace(verdict.Reasoning + " " + note)
+	verdict.Ensemble = &summary
+	return transcript, verdict, nil
+}
@@ -0,0 +1,54 @@
+package deliberation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// runAgent approves in every run except each third, where it rejects.
+type runAgent struct {
+	seats int
+	calls int
+}
+
+func (a *runAgent) Evaluate(c core.Case, p Perspective) Assessment {
+	run := a.calls / a.seats
+	a.calls++
+	if run%3 == 2 {
+		return Assessment{Stance: core.DecisionReject, Reasoning: "Too risky."}
+	}
+	return Assessment{Stance: core.DecisionApprove, Reasoning: "Worth doing."}
+}
+
+func TestDeliberateEnsembleAggregatesRuns(t *testing.T) {
+	c := protocolCase()
+	engine := New(BuildPanel(3, nil, nil))
+	engine.Agent = &runAgent{seats: 3}
+	engine.Seed = 100
+	_, verdict, err := engine.DeliberateEnsemble(c, time.Now().UTC(), 3, 0.9)
+	if err != nil {
+		t.Fatalf("ensemble: %v", err)
+	}
+	e := verdict.Ensemble
+	if e == nil || e.Size != 3 || len(e.Runs) != 3 {
+		t.Fatalf("expected ensemble summary of three runs, got %+v", e)
+	}
+	if verdict.Verdict != core.DecisionApprove || e.Verdicts[core.DecisionApprove] != 2 || e.Runs[2].Seed != 102 {
+		t.Fatalf("expected 2/3 approval with offset seeds, got %+v", e)
+	}
+	if verdict.Binding {
+		t.Fatal("expected agreement below threshold to make the verdict non-binding")
+	}
+	if err := verdict.Validate(); err != nil {
+		t.Fatalf("verdict validation failed: %v", err)
+	}
+}
+
+func TestDeliberateEnsembleRejectsHumanSeats(t *testing.T) {
+	engine := New(append(BuildPanel(2, nil, nil), HumanSeats([]string{"alice"})...))
+	if _, _, err := engine.DeliberateEnsemble(protocolCase(), time.Now().UTC(), 3, 0); err == nil {
+		t.Fatal("expected human seats to be refused")
+	}
+}
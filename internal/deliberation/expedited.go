@@ -0,0 +1,60 @@
+package deliberation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// JudgeSeat is the perspective the judge takes when deciding alone.
+const JudgeSeat = "judge"
+
+// DeliberateExpedited decides a case from the judge alone in a single round,
+// for blockers that cannot wait on the panel. The verdict is marked
+// provisional: it binds as usual until a full-panel deliberation ratifies
+// or overturns it (see Ratify).
+func (e *Engine) DeliberateExpedited(c core.Case, now time.Time) (core.Transcript, core.Verdict, error) {
+	if e.Prior != nil {
+		return core.Transcript{}, core.Verdict{}, errors.New("expedited deliberation cannot resume a prior transcript")
+	}
+	run := *e
+	run.Panel = []Perspective{{Name: JudgeSeat, Model: e.JudgeModel, Directive: "Decide alone and provisionally; a full panel will ratify."}}
+	run.Protocol = ProtocolVote
+	run.Ballots = nil
+	run.Motions = false
+	transcript, verdict, err := run.Deliberate(c, now)
+	if err != nil {
+		return core.Transcript{}, core.Verdict{}, fmt.Errorf("expedited deliberation: %w", err)
+	}
+	verdict.Provisional = true
+	verdict.Reasoning = "Provisional expedited verdict pending full-panel ratification. " + verdict.Reasoning
+	return transcript, verdict, nil
+}
+
+// Ratify settles a provisional verdict with the full panel's verdict on the
+// same case. Matching decisions and outcomes, item by item for compound
+// verdicts, confirm it; anything else
+// overturns it and the panel's verdict stands. The result is never
+// provisional.
+func Ratify(provisional, panel core.Verdict, now time.Time) core.Verdict {
+	status := core.RatificationConfirmed
+	if panel.Verdict != provisional.Verdict || panel.Outcome != provisional.Outcome || len(panel.Items) != len(provisional.Items) {
+		status = core.RatificationOverturned
+	}
+	for i := 0; status == core.RatificationConfirmed && i < len(panel.Items); i++ {
+		if panel.Items[i].Verdict != provisional.Items[i].Verdict || panel.Items[i].Outcome != provisional.Items[i].Outcome {
+			status = core.RatificationOverturned
+		}
+	}
+	panel.Provisional = false
+	panel.Ratification = &core.Ratification{
+		Status:             status,
+		ProvisionalVerdict: provisional.Verdict,
+		ProvisionalOutcome: provisional.Outcome,
+		ProvisionalAt:      provisional.VerdictAt,
+		RatifiedAt:         now.UTC().Format(time.RFC3339),
+	}
+	return panel
+}
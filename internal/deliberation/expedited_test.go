@@ -0,0 +1,38 @@
+package deliberation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+func TestDeliberateExpeditedUsesJudgeAlone(t *testing.T) {
+	now := time.Date(2026, 3, 3, 8, 0, 0, 0, time.UTC)
+	c := core.Case{ID: "senate-exp", Type: "general", Summary: "Unblock the release", Question: "Should we ship the hotfix?", Evidence: []string{"bead:athena-9"}, FiledAt: now.Format(time.RFC3339)}
+	e := New(BuildPanel(3, nil, nil))
+	transcript, verdict, err := e.DeliberateExpedited(c, now)
+	if err != nil {
+		t.Fatalf("expedited: %v", err)
+	}
+	if len(transcript.Panel) != 1 || transcript.Panel[0].Perspective != JudgeSeat || len(transcript.Challenges) != 0 {
+		t.Fatalf("expected a single judge seat, got %+v", transcript.Panel)
+	}
+	if !verdict.Provisional || len(e.Panel) != 3 {
+		t.Fatalf("expected provisional verdict and untouched panel, got %+v", verdict)
+	}
+}
+
+func TestRatifyConfirmsOrOverturns(t *testing.T) {
+	now := time.Date(2026, 3, 4, 8, 0, 0, 0, time.UTC)
+	provisional := core.Verdict{Verdict: core.DecisionApprove, Provisional: true, VerdictAt: now.Add(-time.Hour).Format(time.RFC3339)}
+
+	confirmed := Ratify(provisional, core.Verdict{Verdict: core.DecisionApprove}, now)
+	if confirmed.Provisional || confirmed.Ratification.Status != core.RatificationConfirmed {
+		t.Fatalf("expected confirmation, got %+v", confirmed.Ratification)
+	}
+	overturned := Ratify(provisional, core.Verdict{Verdict: core.DecisionReject}, now)
+	if overturned.Verdict != core.DecisionReject || overturned.Ratification.Status != core.RatificationOverturned || overturned.Ratification.ProvisionalVerdict != core.DecisionApprove {
+		t.Fatalf("expected overturn keeping the panel verdict, got %+v", overturned)
+	}
+}
@@ -0,0 +1,126 @@
+package deliberation
+
+import (
+	"path"
+	"strings"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// ComputeMetrics derives deliberation quality metrics from a transcript.
+// The case supplies the evidence list used to detect citations.
+func ComputeMetrics(c core.Case, t core.Transcript) core.Metrics {
+	m := core.Metrics{
+		InitialAgreement: agreement(t.InitialPositions),
+		FinalAgreement:   agreement(t.FinalPositions),
+		Abstentions:      len(t.FinalPositions) - len(voting(t.FinalPositions)),
+	}
+
+	initialByAgent := make(map[string]core.Decision, len(t.InitialPositions))
+	for _, p := range t.InitialPositions {
+		initialByAgent[p.AgentID] = p.Stance
+	}
+	for _, p := range t.FinalPositions {
+		if before, ok := initialByAgent[p.AgentID]; ok && before != p.Stance {
+			m.StanceChanges++
+		}
+	}
+
+	majority := majorityDecision(countDecisions(t.InitialPositions))
+	challenged := map[string]struct{}{}
+	for _, ch := range t.Challenges {
+		challenged[ch.To] = struct{}{}
+	}
+	_, wholePanel := challenged[PanelAddressee]
+	dissenting, covered := 0, 0
+	for _, p := range t.InitialPositions {
+		if p.Stance == majority || p.Stance == core.DecisionAbstain {
+			continue
+		}
+		dissenting++
+		if _, ok := challenged[p.AgentID]; ok || wholePanel {
+			covered++
+		}
+	}
+	if dissenting == 0 {
+		m.ChallengeCoverage = 1
+	} else {
+		m.ChallengeCoverage = ratio(covered, dissenting)
+	}
+
+	positions := append(append([]core.Position{}, t.InitialPositions...), t.FinalPositions...)
+	citing, words := 0, 0
+	for _, p := range positions {
+		if citesEvidence(p, c.Evidence) {
+			citing++
+		}
+		words += len(strings.Fields(p.Reasoning))
+	}
+	m.EvidenceCitationRate = ratio(citing, len(positions))
+	if len(positions) > 0 {
+		m.MeanReasoningWords = float64(words) / float64(len(positions))
+	}
+	return m
+}
+
+// agreement is the share of non-abstaining seats holding the most common
+// stance, or 0 when every seat abstains. Abstentions are reported separately
+// as Metrics.Abstentions.
+func agreement(positions []core.Position) float64 {
+	positions = voting(positions)
+	best := 0
+	for _, n := range countDecisions(positions) {
+		if n > best {
+			best = n
+		}
+	}
+	return ratio(best, len(positions))
+}
+
+// citesEvidence reports whether a position names an evidence item by its
+// full reference or base name (e.g. "fp-47.md" for "state/reports/fp-47.md").
+func citesEvidence(p core.Position, evidence []string) bool {
+	text := strings.ToLower(p.Reasoning + " " + p.Concerns)
+	for _, e := range evidence {
+		ref := strings.ToLower(strings.TrimSpace(e))
+		if ref == "" {
+			continue
+		}
+		if strings.Contains(text, ref) {
+			return true
+		}
+		if base := path.Base(ref); base != ref && base != "." && strings.Contains(text, base) {
+			return true
+		}
+	}
+	return false
+}
+
+// MeanMetrics averages metrics across transcripts; StanceChanges and
+// Abstentions are rounded down.
+func MeanMetrics(all []core.Metrics) core.Metrics {
+	var sum core.Metrics
+	if len(all) == 0 {
+		return sum
+	}
+	changes, abstentions := 0, 0
+	for _, m := range all {
+		sum.InitialAgreement += m.InitialAgreement
+		sum.FinalAgreement += m.FinalAgreement
+		sum.ChallengeCoverage += m.ChallengeCoverage
+		sum.EvidenceCitationRate += m.EvidenceCitationRate
+		sum.MeanReasoningWords += m.MeanReasoningWords
+		changes += m.StanceChanges
+		abstentions += m.Abstentions
+	}
+	n := float64(len(all))
+	return core.Metrics{
+		InitialAgreement:     sum.InitialAgreement / n,
+		FinalAgreement:       sum.FinalAgreement / n,
+		StanceChanges:        changes / len(all),
+		Abstentions:          abstentions / len(all),
+		ChallengeCoverage:    sum.ChallengeCoverage / n,
+		EvidenceCitationRate: sum.EvidenceCitationRate / n,
+		MeanReasoningWords:   sum.MeanReasoningWords / n,
+	}
+}
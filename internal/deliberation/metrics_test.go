@@ -0,0 +1,62 @@
+package deliberation
+
+import (
+	"testing"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+func TestComputeMetrics(t *testing.T) {
+	c := core.Case{Evidence: []string{"state/reports/fp-47.md"}}
+	tr := core.Transcript{
+		InitialPositions: []core.Position{
+			{AgentID: "agent-1", Stance: core.DecisionApprove, Reasoning: "See fp-47.md for the false positives."},
+			{AgentID: "agent-2", Stance: core.DecisionApprove, Reasoning: "Low risk."},
+			{AgentID: "agent-3", Stance: core.DecisionReject, Reasoning: "Too broad."},
+			{AgentID: "agent-4", Stance: core.DecisionDefer, Reasoning: "Need data."},
+		},
+		Challenges: []core.Challenge{{From: "agent-1", To: "agent-3"}},
+		FinalPositions: []core.Position{
+			{AgentID: "agent-1", Stance: core.DecisionApprove, Reasoning: "Still approve."},
+			{AgentID: "agent-2", Stance: core.DecisionApprove, Reasoning: "Still approve."},
+			{AgentID: "agent-3", Stance: core.DecisionApprove, Reasoning: "Convinced by evidence."},
+			{AgentID: "agent-4", Stance: core.DecisionDefer, Reasoning: "Need data."},
+		},
+	}
+	m := ComputeMetrics(c, tr)
+	if m.InitialAgreement != 0.5 || m.FinalAgreement != 0.75 {
+		t.Fatalf("unexpected agreement: initial=%v final=%v", m.InitialAgreement, m.FinalAgreement)
+	}
+	if m.StanceChanges != 1 {
+		t.Fatalf("expected 1 stance change, got %d", m.StanceChanges)
+	}
+	if m.ChallengeCoverage != 0.5 {
+		t.Fatalf("expected half of dissenters challenged, got %v", m.ChallengeCoverage)
+	}
+	if m.EvidenceCitationRate != 0.125 {
+		t.Fatalf("expected 1 of 8 positions citing evidence, got %v", m.EvidenceCitationRate)
+	}
+}
+
+func TestAgreementIgnoresAbstentions(t *testing.T) {
+	abstain := core.Position{Stance: core.DecisionAbstain}
+	tests := []struct {
+		name      string
+		positions []core.Position
+		want      float64
+	}{
+		{"mostly abstaining", []core.Position{abstain, abstain, abstain, {Stance: core.DecisionApprove}, {Stance: core.DecisionReject}}, 0.5},
+		{"all abstaining", []core.Position{abstain, abstain}, 0},
+		{"no abstentions", []core.Position{{Stance: core.DecisionAmend}, {Stance: core.DecisionAmend}, {Stance: core.DecisionDefer}}, 2.0 / 3},
+	}
+	for _, tt := range tests {
+		if got := agreement(tt.positions); got != tt.want {
+			t.Errorf("%s: agreement = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	m := ComputeMetrics(core.Case{}, core.Transcript{FinalPositions: []core.Position{abstain, abstain, abstain, {Stance: core.DecisionApprove}}})
+	if m.Abstentions != 3 || m.FinalAgreement != 1 {
+		t.Fatalf("expected 3 abstentions reported apart from agreement 1, got %+v", m)
+	}
+}
@@ -0,0 +1,33 @@
+package deliberation
+
+import (
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// maxEvidenceMotions caps how often a case can be sent back to its filer, so
+// a panel that is never satisfied still has to decide.
+const maxEvidenceMotions = 2
+
+// motion reports whether a majority of the seated panel moved for more
+// evidence in an initial round completed by this run. Rounds resumed from a
+// prior transcript have already been weighed and never raise a motion.
+func (e *Engine) motion(prior, rec core.ItemTranscript, round string, raised int, now time.Time) (core.EvidenceMotion, bool) {
+	if !e.Motions || raised >= maxEvidenceMotions || round == core.RoundInitial {
+		return core.EvidenceMotion{}, false
+	}
+	if len(prior.InitialPositions) >= len(rec.InitialPositions) {
+		return core.EvidenceMotion{}, false
+	}
+	m := core.EvidenceMotion{Item: rec.ItemID, RaisedAt: now.UTC().Format(time.RFC3339)}
+	for _, p := range rec.InitialPositions {
+		if p.Motion != "" {
+			m.Requests = append(m.Requests, core.EvidenceRequest{AgentID: p.AgentID, Perspective: p.Perspective, Request: p.Motion})
+		}
+	}
+	if 2*len(m.Requests) <= len(rec.InitialPositions) {
+		return core.EvidenceMotion{}, false
+	}
+	return m, true
+}
@@ -0,0 +1,57 @@
+package deliberation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+func TestEvidenceMotionPausesUntilCaseHasEvidence(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	c := core.Case{ID: "senate-motion", Type: "general", Summary: "Adopt a new cache layer", Question: "Should we adopt the cache layer?", FiledAt: now.Format(time.RFC3339)}
+	e := New(BuildPanel(3, nil, nil))
+	e.Motions = true
+
+	_, _, err := e.Deliberate(c, now)
+	var motion *MotionError
+	if !errors.As(err, &motion) {
+		t.Fatalf("expected evidence motion, got %v", err)
+	}
+	if len(motion.Motion.Requests) < 2 || len(motion.Transcript.Motions) != 1 || len(motion.Transcript.FinalPositions) != 0 {
+		t.Fatalf("unexpected motion: %+v", motion)
+	}
+
+	c.Evidence = []string{"state/reports/cache-misses.md", "bead:athena-42"}
+	e.Prior = &motion.Transcript
+	e.Prior.InitialPositions = nil
+	transcript, verdict, err := e.Deliberate(c, now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("expected verdict after amendment, got %v", err)
+	}
+	if verdict.Verdict == "" || len(transcript.Motions) != 1 {
+		t.Fatalf("expected verdict with motion history, got %s %+v", verdict.Verdict, transcript.Motions)
+	}
+}
+
+func TestEvidenceMotionNeedsMajorityAndOptIn(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	c := core.Case{ID: "senate-motion-2", Type: "general", Summary: "Adopt a new cache layer", Question: "Should we adopt the cache layer?", FiledAt: now.Format(time.RFC3339)}
+	if _, _, err := New(BuildPanel(3, nil, nil)).Deliberate(c, now); err != nil {
+		t.Fatalf("expected motions to be off by default, got %v", err)
+	}
+	e := New(BuildPanel(3, nil, nil))
+	e.Motions = true
+	e.Agent = motionAgent{"skeptic": "Show the incident."}
+	if _, _, err := e.Deliberate(c, now); err != nil {
+		t.Fatalf("expected a single seat's motion to fail, got %v", err)
+	}
+}
+
+// motionAgent moves for evidence from the listed perspectives.
+type motionAgent map[string]string
+
+func (a motionAgent) Evaluate(c core.Case, p Perspective) Assessment {
+	return Assessment{Stance: core.DecisionAmend, Reasoning: "Needs scoping.", Motion: a[p.Name]}
+}
@@ -0,0 +1,134 @@
+package deliberation
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// ProposedOutcome is the rule value replaced by the outcome the case itself proposes.
+const ProposedOutcome = "$proposed"
+
+var numberPattern = regexp.MustCompile(`-?\d+(?:\.\d+)?`)
+
+// proposedOutcome finds the value a case proposes, looking at the requested
+// decision first, then the question and summary.
+func proposedOutcome(c core.Case, schema core.OutcomeSchema) string {
+	for _, text := range []string{c.RequestedDecision, c.Question, c.Summary} {
+		switch schema.Kind {
+		case core.OutcomeEnum:
+			tokens := words(text)
+			for _, o := range schema.Options {
+				if containsPhrase(tokens, words(o), nil) {
+					return o
+				}
+			}
+		case core.OutcomeNumber:
+			for _, n := range numberPattern.FindAllString(text, -1) {
+				if v, err := schema.Normalize(n); err == nil {
+					return v
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// aggregateOutcome picks the panel outcome from final positions that did not
+// defer or abstain: plurality for enums (ties go to the earlier option), median for numbers.
+func aggregateOutcome(schema core.OutcomeSchema, final []core.Position) string {
+	var values []string
+	for _, p := range final {
+		if p.Stance == core.DecisionDefer || p.Stance == core.DecisionAbstain || p.Outcome == "" {
+			continue
+		}
+		if v, err := schema.Normalize(p.Outcome); err == nil {
+			values = append(values, v)
+		}
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	if schema.Kind == core.OutcomeNumber {
+		nums := make([]float64, 0, len(values))
+		for _, v := range values {
+			n, _ := strconv.ParseFloat(v, 64)
+			nums = append(nums, n)
+		}
+		sort.Float64s(nums)
+		mid := len(nums) / 2
+		if len(nums)%2 == 1 {
+			return core.FormatOutcomeNumber(nums[mid])
+		}
+		return core.FormatOutcomeNumber((nums[mid-1] + nums[mid]) / 2)
+	}
+	counts := map[string]int{}
+	for _, v := range values {
+		counts[v]++
+	}
+	best := ""
+	for _, o := range schema.Options {
+		if counts[o] > counts[best] {
+			best = o
+		}
+	}
+	return best
+}
+
+// settleOutcome attaches the typed outcome to a verdict. Multi-option cases
+// are decided by ranked voting and also return the ballot tally. When the case
+// declares its outcome (outcome_schema or options), approvals and amendments
+// without any outcome value fall back to a non-binding deferral; under a
+// case type's built-in vocabulary they stay binding with no outcome.
+func settleOutcome(c core.Case, v core.Verdict) (core.Verdict, *core.BallotTally) {
+	schema := core.OutcomeSchemaFor(c)
+	if schema == nil {
+		return v, nil
+	}
+	v.OutcomeSchema = schema
+	var tally *core.BallotTally
+	if len(c.Options) > 0 {
+		var ballots []core.RankedBallot
+		for _, p := range v.FinalPositions {
+			if p.Stance == core.DecisionDefer || p.Stance == core.DecisionAbstain {
+				continue
+			}
+			if ranking, err := NormalizeRanking(c.Options, p.Ranking); err == nil {
+				ballots = append(ballots, core.RankedBallot{AgentID: p.AgentID, Ranking: ranking})
+			}
+		}
+		t := TallyRankings(c.VotingMethod, c.Options, ballots)
+		tally = &t
+	}
+	if v.Verdict != core.DecisionApprove && v.Verdict != core.DecisionAmend {
+		v.Outcome = ""
+		return v, tally
+	}
+	if tally != nil {
+		v.Outcome = tally.Winner
+	} else {
+		v.Outcome = aggregateOutcome(*schema, v.FinalPositions)
+	}
+	if v.Outcome == "" {
+		if c.OutcomeSchema == nil && len(c.Options) == 0 {
+			return v, tally
+		}
+		v.Verdict = core.DecisionDefer
+		v.Binding = false
+		v.Reasoning = strings.TrimSpace(v.Reasoning + " No seat proposed a valid outcome; deferring.")
+		v.Implementation = buildImplementationText(c, core.DecisionDefer)
+		return v, tally
+	}
+	v.Implementation = strings.TrimSpace(v.Implementation + " Outcome: " + v.Outcome + schemaUnit(*schema) + ".")
+	return v, tally
+}
+
+func schemaUnit(s core.OutcomeSchema) string {
+	if s.Kind == core.OutcomeNumber {
+		return s.Unit
+	}
+	return ""
+}
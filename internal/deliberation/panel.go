@@ -12,6 +12,8 @@ type Perspective struct {
 	Name      string
 	Model     string
 	Directive string
+	// Human seats are filled by a person voting through the CLI.
+	Human bool
 }
 
 var defaultCatalog = []Perspective{
@@ -42,6 +44,58 @@ var defaultCatalog = []Perspective{
 	},
 }
 
+// panelPresets are named panel configurations usable wherever a panel spec is accepted.
+var panelPresets = map[string][]string{
+	"default":  {"pragmatist", "purist", "skeptic"},
+	"full":     {"pragmatist", "purist", "skeptic", "steward", "advocate"},
+	"cautious": {"purist", "skeptic", "steward"},
+	"delivery": {"pragmatist", "advocate", "steward"},
+}
+
+// ParsePanelSpec resolves a preset name (default, full, cautious, delivery)
+// or a "+"-joined perspective list such as "pragmatist+skeptic+skeptic".
+func ParsePanelSpec(spec string, models []string) ([]Perspective, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, fmt.Errorf("empty panel spec")
+	}
+	names, ok := panelPresets[spec]
+	if !ok {
+		for _, part := range strings.Split(spec, "+") {
+			if clean := strings.TrimSpace(part); clean != "" {
+				names = append(names, clean)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("panel spec %q names no perspectives", spec)
+	}
+	panel := make([]Perspective, 0, len(names))
+	for i, name := range names {
+		seat := catalogPerspective(name)
+		if len(models) > 0 {
+			if m := strings.TrimSpace(models[i%len(models)]); m != "" {
+				seat.Model = m
+			}
+		}
+		panel = append(panel, seat)
+	}
+	return panel, nil
+}
+
+func catalogPerspective(name string) Perspective {
+	for _, p := range defaultCatalog {
+		if p.Name == name {
+			return p
+		}
+	}
+	return Perspective{
+		Name:      name,
+		Model:     "claude:sonnet",
+		Directive: fmt.Sprintf("Represent the %s perspective with rigorous argumentation.", name),
+	}
+}
+
 // BuildPanel constructs N panel members with varied perspectives.
 func BuildPanel(n int, names []string, models []string) []Perspective {
 	if n <= 0 {
@@ -83,14 +137,51 @@ func BuildPanel(n int, names []string, models []string) []Perspective {
 	return panel
 }
 
-func toPanelMembers(panel []Perspective) []core.PanelMember {
+// HumanSeats builds human senator seats, one per name.
+func HumanSeats(names []string) []Perspective {
+	out := make([]Perspective, 0, len(names))
+	for _, name := range names {
+		clean := strings.TrimSpace(name)
+		if clean == "" {
+			continue
+		}
+		out = append(out, Perspective{
+			Name:      clean,
+			Model:     core.SeatHuman,
+			Directive: fmt.Sprintf("Human senator %s votes in each round.", clean),
+			Human:     true,
+		})
+	}
+	return out
+}
+
+// PanelFromMembers rebuilds a panel from a stored transcript so a paused
+// deliberation can resume with the same seats.
+func PanelFromMembers(members []core.PanelMember) []Perspective {
+	out := make([]Perspective, 0, len(members))
+	for _, m := range members {
+		p := catalogPerspective(m.Perspective)
+		p.Model = m.Model
+		p.Human = m.Kind == core.SeatHuman
+		out = append(out, p)
+	}
+	return out
+}
+
+// PanelMembers assigns seat ids to a panel: agent-N, or human-N for human seats.
+func PanelMembers(panel []Perspective) []core.PanelMember {
 	out := make([]core.PanelMember, 0, len(panel))
 	for i, p := range panel {
-		out = append(out, core.PanelMember{
+		m := core.PanelMember{
 			AgentID:     fmt.Sprintf("agent-%d", i+1),
 			Model:       p.Model,
 			Perspective: p.Name,
-		})
+		}
+		if p.Human {
+			m.AgentID = fmt.Sprintf("human-%d", i+1)
+			m.Kind = core.SeatHuman
+		}
+		out = append(out, m)
 	}
 	return out
 }
@@ -0,0 +1,253 @@
+package deliberation
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// Registered deliberation protocols.
+const (
+	// ProtocolSenate runs initial positions, cross-challenges and final positions.
+	ProtocolSenate = "senate"
+	// ProtocolDelphi runs anonymized iterative rounds with aggregated feedback.
+	ProtocolDelphi = "delphi"
+	// ProtocolDebate has two advocates argue for and against before the panel.
+	ProtocolDebate = "debate"
+	// ProtocolVote takes one round of positions without challenges.
+	ProtocolVote = "vote"
+)
+
+// PanelAddressee is the challenge target for feedback and arguments put to
+// the whole panel rather than one seat.
+const PanelAddressee = "panel"
+
+// delphiIterations caps the feedback rounds run between initial and final positions.
+const delphiIterations = 3
+
+// protocol collects one question's rounds. It returns the round and seats
+// still waiting on human votes, if any.
+type protocol func(e *Engine, c core.Case, item string, prior core.ItemTranscript) (core.ItemTranscript, string, []core.PanelMember)
+
+var protocols = map[string]protocol{
+	ProtocolSenate: senateRounds,
+	ProtocolDelphi: delphiRounds,
+	ProtocolDebate: debateRounds,
+	ProtocolVote:   voteRounds,
+}
+
+// Protocols lists the registered protocol names in sorted order.
+func Protocols() []string {
+	names := make([]string, 0, len(protocols))
+	for name := range protocols {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidateProtocol accepts a registered protocol name or empty for the default.
+func ValidateProtocol(name string) error {
+	if name == "" {
+		return nil
+	}
+	if _, ok := protocols[name]; !ok {
+		return fmt.Errorf("unknown protocol %q (want %s)", name, strings.Join(Protocols(), ", "))
+	}
+	return nil
+}
+
+func senateRounds(e *Engine, c core.Case, item string, prior core.ItemTranscript) (core.ItemTranscript, string, []core.PanelMember) {
+	var rec core.ItemTranscript
+	initial, waiting := e.initialRound(c, item, prior.InitialPositions)
+	rec.InitialPositions = initial
+	if len(waiting) > 0 {
+		return rec, core.RoundInitial, waiting
+	}
+	rec.Challenges = buildChallenges(c, initial)
+	proposed := finalizePositions(c, initial, rec.Challenges)
+	rec.FinalPositions, waiting = e.finalRound(c, item, rec, proposed, prior.FinalPositions)
+	if len(waiting) > 0 {
+		return rec, core.RoundFinal, waiting
+	}
+	return rec, "", nil
+}
+
+// voteRounds records each seat's initial position as final, skipping
+// challenges; human seats vote once.
+func voteRounds(e *Engine, c core.Case, item string, prior core.ItemTranscript) (core.ItemTranscript, string, []core.PanelMember) {
+	var rec core.ItemTranscript
+	initial, waiting := e.initialRound(c, item, prior.InitialPositions)
+	rec.InitialPositions = initial
+	rec.Challenges = []core.Challenge{}
+	if len(waiting) > 0 {
+		return rec, core.RoundInitial, waiting
+	}
+	for _, p := range initial {
+		p.Round = core.RoundFinal
+		rec.FinalPositions = append(rec.FinalPositions, p)
+	}
+	return rec, "", nil
+}
+
+// delphiRounds feeds the anonymous stance distribution back to the panel
+// after each round. Seats outside the modal stance step one place toward it
+// (rejected, amended, approved; deferred jumps straight to it) and numeric
+// outcomes move halfway to the median, until nobody moves or the iteration
+// cap is reached. Feedback is recorded as facilitator challenges.
+func delphiRounds(e *Engine, c core.Case, item string, prior core.ItemTranscript) (core.ItemTranscript, string, []core.PanelMember) {
+	var rec core.ItemTranscript
+	initial, waiting := e.initialRound(c, item, prior.InitialPositions)
+	rec.InitialPositions = initial
+	if len(waiting) > 0 {
+		return rec, core.RoundInitial, waiting
+	}
+
+	schema := core.OutcomeSchemaFor(c)
+	revised := make([]core.Position, len(initial))
+	copy(revised, initial)
+	rec.Challenges = []core.Challenge{}
+	for n := 1; n <= delphiIterations; n++ {
+		counts := countDecisions(revised)
+		modal := modalStance(counts)
+		median := ""
+		if schema != nil && schema.Kind == core.OutcomeNumber {
+			median = aggregateOutcome(*schema, revised)
+		}
+		rec.Challenges = append(rec.Challenges, core.Challenge{
+			From:      "facilitator",
+			To:        PanelAddressee,
+			Challenge: delphiFeedback(n, counts, median),
+		})
+		moved := false
+		for i := range revised {
+			p := &revised[i]
+			if p.Stance == core.DecisionAbstain {
+				continue
+			}
+			if counts[p.Stance] < counts[modal] {
+				p.Stance = stepToward(p.Stance, modal)
+				p.Reasoning = fmt.Sprintf("Revised toward the panel's %s majority after round %d feedback.", modal, n)
+				moved = true
+			}
+			if median != "" && p.Outcome != "" && p.Outcome != median {
+				p.Outcome = halfway(*schema, p.Outcome, median)
+				moved = true
+			}
+		}
+		if !moved {
+			break
+		}
+	}
+	for i := range revised {
+		revised[i].Round = core.RoundFinal
+	}
+	rec.FinalPositions, waiting = e.finalRound(c, item, rec, revised, prior.FinalPositions)
+	if len(waiting) > 0 {
+		return rec, core.RoundFinal, waiting
+	}
+	return rec, "", nil
+}
+
+func delphiFeedback(round int, counts map[core.Decision]int, median string) string {
+	parts := make([]string, 0, 4)
+	for _, d := range []core.Decision{core.DecisionApprove, core.DecisionReject, core.DecisionAmend, core.DecisionDefer} {
+		if counts[d] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[d], d))
+		}
+	}
+	text := fmt.Sprintf("Round %d panel distribution: %s.", round, strings.Join(parts, ", "))
+	if median != "" {
+		text += " Median outcome: " + median + "."
+	}
+	return text
+}
+
+func stepToward(from, to core.Decision) core.Decision {
+	if from == core.DecisionDefer || to == core.DecisionDefer {
+		return to
+	}
+	scale := map[core.Decision]int{core.DecisionReject: 0, core.DecisionAmend: 1, core.DecisionApprove: 2}
+	switch {
+	case scale[from] < scale[to]:
+		return []core.Decision{core.DecisionReject, core.DecisionAmend, core.DecisionApprove}[scale[from]+1]
+	case scale[from] > scale[to]:
+		return []core.Decision{core.DecisionReject, core.DecisionAmend, core.DecisionApprove}[scale[from]-1]
+	}
+	return from
+}
+
+func halfway(schema core.OutcomeSchema, value, target string) string {
+	v, err1 := strconv.ParseFloat(value, 64)
+	t, err2 := strconv.ParseFloat(target, 64)
+	if err1 != nil || err2 != nil {
+		return value
+	}
+	next := core.FormatOutcomeNumber(v + (t-v)/2)
+	if _, err := schema.Normalize(next); err != nil {
+		return value
+	}
+	return next
+}
+
+// debateRounds has an advocate for and against the case argue from the
+// panel's initial reasoning. The side with more initial support wins the
+// debate: deferring seats adopt its stance (amended for, rejected against)
+// and the remaining seats keep theirs. An even debate changes nothing.
+func debateRounds(e *Engine, c core.Case, item string, prior core.ItemTranscript) (core.ItemTranscript, string, []core.PanelMember) {
+	var rec core.ItemTranscript
+	initial, waiting := e.initialRound(c, item, prior.InitialPositions)
+	rec.InitialPositions = initial
+	if len(waiting) > 0 {
+		return rec, core.RoundInitial, waiting
+	}
+
+	var forArgs, againstArgs []string
+	support, opposition := 0, 0
+	for _, p := range initial {
+		switch p.Stance {
+		case core.DecisionApprove, core.DecisionAmend:
+			support++
+			forArgs = append(forArgs, p.Reasoning)
+		case core.DecisionReject:
+			opposition++
+			againstArgs = append(againstArgs, p.Reasoning, p.Concerns)
+		}
+	}
+	rec.Challenges = []core.Challenge{
+		{From: "advocate-for", To: PanelAddressee, Challenge: advocacy("for", c, uniqueFirstN(forArgs, 2))},
+		{From: "advocate-against", To: PanelAddressee, Challenge: advocacy("against", c, uniqueFirstN(againstArgs, 2))},
+	}
+
+	winner := core.Decision("")
+	switch {
+	case support > opposition:
+		winner = core.DecisionAmend
+	case opposition > support:
+		winner = core.DecisionReject
+	}
+	proposed := make([]core.Position, 0, len(initial))
+	for _, p := range initial {
+		p.Round = core.RoundFinal
+		if winner != "" && p.Stance == core.DecisionDefer {
+			p.Stance = winner
+			p.Reasoning = fmt.Sprintf("The case %s carried the debate; moving from deferral to %s.", map[core.Decision]string{core.DecisionAmend: "for", core.DecisionReject: "against"}[winner], winner)
+		}
+		proposed = append(proposed, p)
+	}
+	rec.FinalPositions, waiting = e.finalRound(c, item, rec, proposed, prior.FinalPositions)
+	if len(waiting) > 0 {
+		return rec, core.RoundFinal, waiting
+	}
+	return rec, "", nil
+}
+
+func advocacy(side string, c core.Case, points []string) string {
+	if len(points) == 0 {
+		return fmt.Sprintf("The case %s %s rests on the question alone: %s", side, c.ID, c.Question)
+	}
+	return fmt.Sprintf("The case %s %s: %s", side, c.ID, strings.Join(points, " "))
+}
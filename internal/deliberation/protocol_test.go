@@ -0,0 +1,91 @@
+package deliberation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+func protocolCase() core.Case {
+	return core.Case{
+		ID:       "senate-010",
+		Type:     "general",
+		Summary:  "Enable retries for flaky uploads",
+		Question: "Should we enable retries with staged rollout and test evidence despite the outage risk?",
+		Evidence: []string{"upload error log"},
+		FiledAt:  time.Now().UTC().Format(time.RFC3339),
+	}
+}
+
+func TestVoteProtocolSkipsChallenges(t *testing.T) {
+	engine := New(BuildPanel(5, nil, nil))
+	engine.Protocol = ProtocolVote
+	transcript, verdict, err := engine.Deliberate(protocolCase(), time.Now().UTC())
+	if err != nil {
+		t.Fatalf("deliberate: %v", err)
+	}
+	if len(transcript.Challenges) != 0 || transcript.Protocol != ProtocolVote {
+		t.Fatalf("expected no challenges under vote protocol, got %+v", transcript.Challenges)
+	}
+	for i, p := range transcript.FinalPositions {
+		if p.Stance != transcript.InitialPositions[i].Stance || p.Round != core.RoundFinal {
+			t.Fatalf("expected final positions to repeat initial ones, got %+v", p)
+		}
+	}
+	if err := verdict.Validate(); err != nil {
+		t.Fatalf("verdict validation failed: %v", err)
+	}
+}
+
+func TestDelphiProtocolConverges(t *testing.T) {
+	engine := New(BuildPanel(5, nil, nil))
+	engine.Protocol = ProtocolDelphi
+	transcript, _, err := engine.Deliberate(protocolCase(), time.Now().UTC())
+	if err != nil {
+		t.Fatalf("deliberate: %v", err)
+	}
+	if len(transcript.Challenges) == 0 || transcript.Challenges[0].From != "facilitator" || transcript.Challenges[0].To != PanelAddressee {
+		t.Fatalf("expected anonymous facilitator feedback, got %+v", transcript.Challenges)
+	}
+	if len(transcript.Challenges) > delphiIterations {
+		t.Fatalf("expected at most %d feedback rounds, got %d", delphiIterations, len(transcript.Challenges))
+	}
+	if transcript.Metrics.FinalAgreement < transcript.Metrics.InitialAgreement {
+		t.Fatalf("expected agreement not to drop: %+v", transcript.Metrics)
+	}
+}
+
+func TestDebateProtocolHearsBothAdvocates(t *testing.T) {
+	engine := New(BuildPanel(3, nil, nil))
+	engine.Protocol = ProtocolDebate
+	transcript, _, err := engine.Deliberate(protocolCase(), time.Now().UTC())
+	if err != nil {
+		t.Fatalf("deliberate: %v", err)
+	}
+	if len(transcript.Challenges) != 2 || transcript.Challenges[0].From != "advocate-for" || transcript.Challenges[1].From != "advocate-against" {
+		t.Fatalf("expected two advocates, got %+v", transcript.Challenges)
+	}
+}
+
+func TestUnknownProtocolRejected(t *testing.T) {
+	engine := New(nil)
+	engine.Protocol = "filibuster"
+	if _, _, err := engine.Deliberate(protocolCase(), time.Now().UTC()); err == nil {
+		t.Fatal("expected unknown protocol error")
+	}
+}
+
+func TestStepToward(t *testing.T) {
+	cases := []struct{ from, to, want core.Decision }{
+		{core.DecisionReject, core.DecisionApprove, core.DecisionAmend},
+		{core.DecisionApprove, core.DecisionReject, core.DecisionAmend},
+		{core.DecisionDefer, core.DecisionReject, core.DecisionReject},
+		{core.DecisionAmend, core.DecisionApprove, core.DecisionApprove},
+	}
+	for _, tc := range cases {
+		if got := stepToward(tc.from, tc.to); got != tc.want {
+			t.Fatalf("stepToward(%s, %s) = %s, want %s", tc.from, tc.to, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package deliberation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// NormalizeRanking maps a ranking onto the declared option spellings,
+// rejecting unknown and repeated options. Partial rankings are allowed.
+func NormalizeRanking(options, ranking []string) ([]string, error) {
+	schema := core.OutcomeSchema{Kind: core.OutcomeEnum, Options: options}
+	seen := map[string]struct{}{}
+	out := make([]string, 0, len(ranking))
+	for _, r := range ranking {
+		o, err := schema.Normalize(r)
+		if err != nil {
+			return nil, err
+		}
+		if _, dup := seen[o]; dup {
+			return nil, fmt.Errorf("option %q ranked twice", o)
+		}
+		seen[o] = struct{}{}
+		out = append(out, o)
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("ranking is empty")
+	}
+	return out, nil
+}
+
+// TallyRankings decides a multi-option case. Instant-runoff eliminates the
+// weakest option each round (ties eliminate the later-listed option), Borda
+// awards n-1..0 points by rank, and Condorcet picks the option beating every
+// other head-to-head, falling back to Borda when there is none. Ties on
+// points always go to the earlier-listed option.
+func TallyRankings(method string, options []string, ballots []core.RankedBallot) core.BallotTally {
+	if method == "" {
+		method = core.VotingInstantRunoff
+	}
+	tally := core.BallotTally{Method: method, Options: options, Ballots: ballots}
+	if len(ballots) == 0 {
+		return tally
+	}
+	switch method {
+	case core.VotingBorda:
+		tally.Scores = bordaScores(options, ballots)
+		tally.Winner = bestScore(options, tally.Scores)
+	case core.VotingCondorcet:
+		tally.Pairwise = pairwise(options, ballots)
+		tally.Winner = condorcetWinner(options, tally.Pairwise)
+		if tally.Winner == "" {
+			tally.Fallback = core.VotingBorda
+			tally.Scores = bordaScores(options, ballots)
+			tally.Winner = bestScore(options, tally.Scores)
+		}
+	default:
+		tally.Rounds, tally.Winner = instantRunoff(options, ballots)
+	}
+	return tally
+}
+
+func instantRunoff(options []string, ballots []core.RankedBallot) ([]map[string]int, string) {
+	remaining := map[string]bool{}
+	for _, o := range options {
+		remaining[o] = true
+	}
+	var rounds []map[string]int
+	for len(remaining) > 0 {
+		counts := map[string]int{}
+		for o := range remaining {
+			counts[o] = 0
+		}
+		active := 0
+		for _, b := range ballots {
+			for _, choice := range b.Ranking {
+				if remaining[choice] {
+					counts[choice]++
+					active++
+					break
+				}
+			}
+		}
+		rounds = append(rounds, counts)
+		if active == 0 {
+			return rounds, ""
+		}
+		for _, o := range options {
+			if remaining[o] && (counts[o]*2 > active || len(remaining) == 1) {
+				return rounds, o
+			}
+		}
+		weakest := ""
+		for _, o := range options {
+			if remaining[o] && (weakest == "" || counts[o] <= counts[weakest]) {
+				weakest = o
+			}
+		}
+		delete(remaining, weakest)
+	}
+	return rounds, ""
+}
+
+func bordaScores(options []string, ballots []core.RankedBallot) map[string]int {
+	scores := make(map[string]int, len(options))
+	for _, o := range options {
+		scores[o] = 0
+	}
+	n := len(options)
+	for _, b := range ballots {
+		for i, choice := range b.Ranking {
+			scores[choice] += n - 1 - i
+		}
+	}
+	return scores
+}
+
+func bestScore(options []string, scores map[string]int) string {
+	best := ""
+	for _, o := range options {
+		if best == "" || scores[o] > scores[best] {
+			best = o
+		}
+	}
+	return best
+}
+
+// pairwise counts, for each ordered pair, ballots ranking a above b.
+// Unranked options sit below every ranked one.
+func pairwise(options []string, ballots []core.RankedBallot) map[string]map[string]int {
+	out := make(map[string]map[string]int, len(options))
+	for _, a := range options {
+		out[a] = map[string]int{}
+	}
+	for _, b := range ballots {
+		pos := map[string]int{}
+		for i, choice := range b.Ranking {
+			pos[choice] = i
+		}
+		for _, a := range options {
+			for _, c := range options {
+				if a == c {
+					continue
+				}
+				pa, aRanked := pos[a]
+				pc, cRanked := pos[c]
+				if aRanked && (!cRanked || pa < pc) {
+					out[a][c]++
+				}
+			}
+		}
+	}
+	return out
+}
+
+func condorcetWinner(options []string, pw map[string]map[string]int) string {
+	for _, a := range options {
+		wins := true
+		for _, c := range options {
+			if a != c && pw[a][c] <= pw[c][a] {
+				wins = false
+				break
+			}
+		}
+		if wins {
+			return a
+		}
+	}
+	return ""
+}
+
+// rankOptions orders case options for a seat by how the seat's rules judge each
+// option on its own: approve, then amend, defer, reject; ties keep listed order.
+func (a *RuleAgent) rankOptions(c core.Case, p Perspective) []string {
+	order := map[core.Decision]int{core.DecisionApprove: 0, core.DecisionAmend: 1, core.DecisionDefer: 2, core.DecisionAbstain: 2, core.DecisionReject: 3}
+	type ranked struct {
+		option string
+		rank   int
+	}
+	items := make([]ranked, 0, len(c.Options))
+	for _, o := range c.Options {
+		sub := core.Case{Question: o, Summary: strings.TrimSpace(o), Evidence: c.Evidence}
+		stance := a.Rules.Evaluate(p.Name, a.Rules.Scores(sub)).Stance
+		items = append(items, ranked{option: o, rank: order[stance]})
+	}
+	out := make([]string, 0, len(items))
+	for r := 0; r <= 3; r++ {
+		for _, it := range items {
+			if it.rank == r {
+				out = append(out, it.option)
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,69 @@
+package deliberation
+
+import (
+	"testing"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+func ballots(rankings ...[]string) []core.RankedBallot {
+	out := make([]core.RankedBallot, 0, len(rankings))
+	for _, r := range rankings {
+		out = append(out, core.RankedBallot{Ranking: r})
+	}
+	return out
+}
+
+func TestTallyRankingsInstantRunoffTransfersVotes(t *testing.T) {
+	options := []string{"a", "b", "c"}
+	tally := TallyRankings(core.VotingInstantRunoff, options, ballots(
+		[]string{"a", "b"},
+		[]string{"a", "c"},
+		[]string{"b", "c"},
+		[]string{"c", "b"},
+		[]string{"c", "b"},
+	))
+	if tally.Winner != "c" {
+		t.Fatalf("expected c after b's transfer, got %q", tally.Winner)
+	}
+	if len(tally.Rounds) != 2 {
+		t.Fatalf("expected 2 runoff rounds, got %d", len(tally.Rounds))
+	}
+}
+
+func TestTallyRankingsBordaAndCondorcetFallback(t *testing.T) {
+	options := []string{"a", "b", "c"}
+	cycle := ballots(
+		[]string{"a", "b", "c"},
+		[]string{"b", "c", "a"},
+		[]string{"c", "a", "b"},
+	)
+	tally := TallyRankings(core.VotingCondorcet, options, cycle)
+	if tally.Fallback != core.VotingBorda || tally.Winner != "a" {
+		t.Fatalf("expected borda fallback picking earliest tied option a, got %q via %q", tally.Winner, tally.Fallback)
+	}
+
+	tally = TallyRankings(core.VotingCondorcet, options, ballots(
+		[]string{"b", "a"},
+		[]string{"b", "c"},
+		[]string{"a", "b"},
+	))
+	if tally.Winner != "b" || tally.Fallback != "" {
+		t.Fatalf("expected condorcet winner b, got %q (fallback %q)", tally.Winner, tally.Fallback)
+	}
+
+	tally = TallyRankings(core.VotingBorda, options, ballots([]string{"c", "b", "a"}, []string{"b", "c", "a"}, []string{"b"}))
+	if tally.Winner != "b" || tally.Scores["b"] != 5 {
+		t.Fatalf("expected borda winner b with 5 points, got %q %v", tally.Winner, tally.Scores)
+	}
+}
+
+func TestNormalizeRankingRejectsDuplicates(t *testing.T) {
+	if _, err := NormalizeRanking([]string{"Alpha", "Beta"}, []string{"alpha", "ALPHA"}); err == nil {
+		t.Fatal("expected duplicate ranking error")
+	}
+	got, err := NormalizeRanking([]string{"Alpha", "Beta"}, []string{"beta"})
+	if err != nil || got[0] != "Beta" {
+		t.Fatalf("expected canonical partial ranking, got %v (%v)", got, err)
+	}
+}
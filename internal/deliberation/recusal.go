@@ -0,0 +1,72 @@
+package deliberation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// Recusals lists the seats that must withdraw from a case: a seat whose
+// agent id or senator name matches the filer, or one the case names as
+// conflicted. Matching ignores case.
+func Recusals(c core.Case, seats []core.PanelMember) []core.Recusal {
+	var out []core.Recusal
+	for _, seat := range seats {
+		reason := ""
+		switch {
+		case seatIs(seat, c.FiledBy):
+			reason = fmt.Sprintf("seat filed case %s", c.ID)
+		default:
+			for _, conflict := range c.Conflicts {
+				if seatIs(seat, conflict) {
+					reason = "declared conflict"
+					break
+				}
+			}
+		}
+		if reason != "" {
+			out = append(out, core.Recusal{AgentID: seat.AgentID, Perspective: seat.Perspective, Reason: reason})
+		}
+	}
+	return out
+}
+
+func seatIs(seat core.PanelMember, name string) bool {
+	name = strings.TrimSpace(name)
+	return name != "" && (strings.EqualFold(name, seat.AgentID) || strings.EqualFold(name, seat.Perspective))
+}
+
+func recusedSeats(c core.Case, seats []core.PanelMember) map[string]struct{} {
+	out := map[string]struct{}{}
+	for _, r := range Recusals(c, seats) {
+		out[r.AgentID] = struct{}{}
+	}
+	return out
+}
+
+// countQuorum measures final positions against the seats eligible to sit.
+func countQuorum(seats []core.PanelMember, recused int, final []core.Position) core.Quorum {
+	q := core.Quorum{Seats: len(seats), Recused: recused, Eligible: len(seats) - recused}
+	for _, p := range final {
+		if p.Stance == core.DecisionAbstain {
+			q.Abstained++
+		} else {
+			q.Voting++
+		}
+	}
+	q.Required = q.Eligible/2 + 1
+	q.Met = q.Voting >= q.Required
+	return q
+}
+
+// voting drops abstentions from a set of positions.
+func voting(positions []core.Position) []core.Position {
+	out := make([]core.Position, 0, len(positions))
+	for _, p := range positions {
+		if p.Stance != core.DecisionAbstain {
+			out = append(out, p)
+		}
+	}
+	return out
+}
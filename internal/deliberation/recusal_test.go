@@ -0,0 +1,57 @@
+package deliberation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// stanceAgent returns a fixed stance per perspective, approving otherwise.
+type stanceAgent map[string]core.Decision
+
+func (a stanceAgent) Evaluate(c core.Case, p Perspective) Assessment {
+	if s, ok := a[p.Name]; ok {
+		return Assessment{Stance: s, Reasoning: "Fixed stance for " + p.Name + "."}
+	}
+	return Assessment{Stance: core.DecisionApprove, Reasoning: "Worth doing."}
+}
+
+func TestRecusalsMatchFilerAndConflicts(t *testing.T) {
+	seats := PanelMembers(BuildPanel(3, []string{"pragmatist", "purist", "skeptic"}, nil))
+	c := core.Case{ID: "senate-020", FiledBy: "Skeptic", Conflicts: []string{"agent-1"}}
+	got := Recusals(c, seats)
+	if len(got) != 2 || got[0].AgentID != "agent-1" || got[1].Perspective != "skeptic" {
+		t.Fatalf("expected agent-1 and the filing skeptic recused, got %+v", got)
+	}
+}
+
+func TestAbstentionsAndRecusalsRecalculateQuorum(t *testing.T) {
+	c := protocolCase()
+	c.FiledBy = "skeptic"
+	engine := New(BuildPanel(5, []string{"pragmatist", "purist", "skeptic", "steward", "advocate"}, nil))
+	engine.Agent = stanceAgent{"purist": core.DecisionAbstain, "steward": core.DecisionReject}
+	transcript, verdict, err := engine.Deliberate(c, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("deliberate: %v", err)
+	}
+	if len(transcript.Recusals) != 1 || len(transcript.FinalPositions) != 4 {
+		t.Fatalf("expected the filer recused from both rounds, got %+v", transcript.Recusals)
+	}
+	q := transcript.Quorum
+	if q == nil || q.Eligible != 4 || q.Abstained != 1 || q.Voting != 3 || q.Required != 3 || !q.Met {
+		t.Fatalf("unexpected quorum: %+v", q)
+	}
+	if verdict.Verdict == core.DecisionAbstain || verdict.Verdict == core.DecisionDefer {
+		t.Fatalf("expected the two approvals to carry, got %s", verdict.Verdict)
+	}
+
+	engine.Agent = stanceAgent{"purist": core.DecisionAbstain, "steward": core.DecisionAbstain}
+	_, verdict, err = engine.Deliberate(c, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("deliberate: %v", err)
+	}
+	if verdict.Verdict != core.DecisionDefer || verdict.Binding {
+		t.Fatalf("expected deferral without quorum, got %s binding=%t", verdict.Verdict, verdict.Binding)
+	}
+}
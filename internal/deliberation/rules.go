@@ -0,0 +1,453 @@
+package deliberation
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// EvidenceSignal is the built-in signal holding the number of case evidence items.
+const EvidenceSignal = "evidence"
+
+//go:embed default_rules.json
+var defaultRulesJSON []byte
+
+// Rules is the declarative scoring configuration used by RuleAgent.
+type Rules struct {
+	Negations      []string                    `json:"negations,omitempty"`
+	NegationWindow int                         `json:"negation_window,omitempty"`
+	Signals        map[string]Signal           `json:"signals"`
+	Perspectives   map[string]PerspectiveRules `json:"perspectives"`
+	Default        PerspectiveRules            `json:"default"`
+	// Outcomes maps case types to the typed outcome each seat proposes.
+	Outcomes map[string]OutcomeRules `json:"outcomes,omitempty"`
+}
+
+// Signal is a weighted vocabulary scored against case text.
+type Signal struct {
+	Tokens []Token `json:"tokens"`
+}
+
+// Token is one term of a signal vocabulary. Multi-word terms match as phrases.
+// Variants are other forms of the term ("dropped", "dropping") that count as
+// it; the token scores once whichever form matches. An Absent token names a
+// safeguard and scores only where it is negated ("no rollback plan",
+// "without review"), while other tokens score only where they are not.
+type Token struct {
+	Term     string   `json:"term"`
+	Variants []string `json:"variants,omitempty"`
+	Weight   float64  `json:"weight,omitempty"`
+	Absent   bool     `json:"absent,omitempty"`
+}
+
+// UnmarshalJSON accepts either a bare string or a {"term","weight"} object.
+func (t *Token) UnmarshalJSON(data []byte) error {
+	var term string
+	if err := json.Unmarshal(data, &term); err == nil {
+		*t = Token{Term: term}
+		return nil
+	}
+	type plain Token
+	var p plain
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	*t = Token(p)
+	return nil
+}
+
+// PerspectiveRules maps signal scores to a stance for one perspective.
+// Rules are evaluated in order; the first match wins, otherwise Fallback applies.
+type PerspectiveRules struct {
+	Rules    []Rule  `json:"rules"`
+	Fallback Outcome `json:"fallback"`
+}
+
+// Rule yields its outcome when every threshold in When is satisfied.
+type Rule struct {
+	When map[string]Threshold `json:"when"`
+	Outcome
+}
+
+// OutcomeRules choose a typed outcome value for one case type.
+type OutcomeRules struct {
+	Perspectives map[string]ValueRules `json:"perspectives,omitempty"`
+	Default      ValueRules            `json:"default"`
+}
+
+// ValueRules yield the first matching rule's value, else Fallback. The value
+// "$proposed" stands for the outcome the case itself proposes.
+type ValueRules struct {
+	Rules    []ValueRule `json:"rules,omitempty"`
+	Fallback string      `json:"fallback,omitempty"`
+}
+
+// ValueRule yields Value when every threshold in When is satisfied.
+type ValueRule struct {
+	When  map[string]Threshold `json:"when"`
+	Value string               `json:"value"`
+}
+
+// Threshold bounds a signal score (inclusive on both ends).
+type Threshold struct {
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+}
+
+// Outcome is the stance and rationale a rule produces.
+type Outcome struct {
+	Stance    core.Decision `json:"stance"`
+	Reasoning string        `json:"reasoning"`
+	Concerns  string        `json:"concerns,omitempty"`
+	// Motion asks the filer for specific evidence before the panel decides.
+	Motion string `json:"motion,omitempty"`
+}
+
+// DefaultRules returns the built-in rules shipped with Senate.
+func DefaultRules() *Rules {
+	r, err := ParseRules(defaultRulesJSON)
+	if err != nil {
+		panic(fmt.Sprintf("invalid built-in rules: %v", err))
+	}
+	return r
+}
+
+// LoadRules reads and validates a rules file.
+func LoadRules(path string) (*Rules, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	r, err := ParseRules(data)
+	if err != nil {
+		return nil, fmt.Errorf("rules %s: %w", path, err)
+	}
+	return r, nil
+}
+
+// ParseRules decodes and validates rules from JSON.
+func ParseRules(data []byte) (*Rules, error) {
+	var r Rules
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("decode rules: %w", err)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (r *Rules) Validate() error {
+	if len(r.Signals) == 0 {
+		return errors.New("rules.signals must not be empty")
+	}
+	if _, ok := r.Signals[EvidenceSignal]; ok {
+		return fmt.Errorf("rules.signals.%s is reserved", EvidenceSignal)
+	}
+	if r.NegationWindow < 0 {
+		return errors.New("rules.negation_window must not be negative")
+	}
+	for name, sig := range r.Signals {
+		if len(sig.Tokens) == 0 {
+			return fmt.Errorf("rules.signals.%s.tokens must not be empty", name)
+		}
+		for i, tok := range sig.Tokens {
+			if len(words(tok.Term)) == 0 {
+				return fmt.Errorf("rules.signals.%s.tokens[%d] must contain a word", name, i)
+			}
+			for j, v := range tok.Variants {
+				if len(words(v)) == 0 {
+					return fmt.Errorf("rules.signals.%s.tokens[%d].variants[%d] must contain a word", name, i, j)
+				}
+			}
+			if tok.Weight < 0 {
+				return fmt.Errorf("rules.signals.%s.tokens[%d].weight must not be negative", name, i)
+			}
+		}
+	}
+	if err := r.Default.validate(r, "rules.default"); err != nil {
+		return err
+	}
+	for name, pr := range r.Perspectives {
+		if err := pr.validate(r, "rules.perspectives."+name); err != nil {
+			return err
+		}
+	}
+	for caseType, or := range r.Outcomes {
+		field := "rules.outcomes." + caseType
+		if err := or.Default.validate(r, field+".default"); err != nil {
+			return err
+		}
+		for name, vr := range or.Perspectives {
+			if err := vr.validate(r, field+".perspectives."+name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (vr ValueRules) validate(r *Rules, field string) error {
+	for i, rule := range vr.Rules {
+		ruleField := fmt.Sprintf("%s.rules[%d]", field, i)
+		if err := r.validateWhen(rule.When, ruleField); err != nil {
+			return err
+		}
+		if strings.TrimSpace(rule.Value) == "" {
+			return fmt.Errorf("%s.value is required", ruleField)
+		}
+	}
+	return nil
+}
+
+func (r *Rules) validateWhen(when map[string]Threshold, field string) error {
+	if len(when) == 0 {
+		return fmt.Errorf("%s.when must not be empty", field)
+	}
+	for sig, th := range when {
+		if _, ok := r.Signals[sig]; !ok && sig != EvidenceSignal {
+			return fmt.Errorf("%s.when references unknown signal %q", field, sig)
+		}
+		if th.Min == nil && th.Max == nil {
+			return fmt.Errorf("%s.when.%s needs min or max", field, sig)
+		}
+	}
+	return nil
+}
+
+func (pr PerspectiveRules) validate(r *Rules, field string) error {
+	if err := pr.Fallback.validate(field + ".fallback"); err != nil {
+		return err
+	}
+	for i, rule := range pr.Rules {
+		ruleField := fmt.Sprintf("%s.rules[%d]", field, i)
+		if err := r.validateWhen(rule.When, ruleField); err != nil {
+			return err
+		}
+		if err := rule.Outcome.validate(ruleField); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o Outcome) validate(field string) error {
+	if err := o.Stance.ValidateStance(); err != nil {
+		return fmt.Errorf("%s.stance: %w", field, err)
+	}
+	if strings.TrimSpace(o.Reasoning) == "" {
+		return fmt.Errorf("%s.reasoning is required", field)
+	}
+	return nil
+}
+
+func (t Threshold) match(score float64) bool {
+	if t.Min != nil && score < *t.Min {
+		return false
+	}
+	if t.Max != nil && score > *t.Max {
+		return false
+	}
+	return true
+}
+
+// Scores computes every signal score for a case, including the built-in evidence count.
+func (r *Rules) Scores(c core.Case) map[string]float64 {
+	clauses := clauseWords(c.Question + ". " + c.Summary)
+	scores := make(map[string]float64, len(r.Signals)+1)
+	for name, sig := range r.Signals {
+		scores[name] = r.score(clauses, sig)
+	}
+	scores[EvidenceSignal] = float64(len(c.Evidence))
+	return scores
+}
+
+// Evaluate returns the outcome for a perspective given precomputed scores.
+func (r *Rules) Evaluate(perspective string, scores map[string]float64) Outcome {
+	pr, ok := r.Perspectives[perspective]
+	if !ok {
+		pr = r.Default
+	}
+	for _, rule := range pr.Rules {
+		if matchAll(rule.When, scores) {
+			return rule.Outcome
+		}
+	}
+	return pr.Fallback
+}
+
+// EvaluateOutcome returns the raw outcome value a perspective proposes for a
+// case type, or "" when the rules hold no outcome entry for it.
+func (r *Rules) EvaluateOutcome(caseType, perspective string, scores map[string]float64) string {
+	or, ok := r.Outcomes[caseType]
+	if !ok {
+		return ""
+	}
+	vr, ok := or.Perspectives[perspective]
+	if !ok {
+		vr = or.Default
+	}
+	for _, rule := range vr.Rules {
+		if matchAll(rule.When, scores) {
+			return rule.Value
+		}
+	}
+	return vr.Fallback
+}
+
+func matchAll(when map[string]Threshold, scores map[string]float64) bool {
+	for sig, th := range when {
+		if !th.match(scores[sig]) {
+			return false
+		}
+	}
+	return true
+}
+
+// score sums the weight of each token found at least once, outside a
+// negation or, for an absent token, inside one.
+func (r *Rules) score(clauses [][]string, sig Signal) float64 {
+	total := 0.0
+	for _, tok := range sig.Tokens {
+		if r.matches(clauses, tok) {
+			w := tok.Weight
+			if w == 0 {
+				w = 1
+			}
+			total += w
+		}
+	}
+	return total
+}
+
+func (r *Rules) matches(clauses [][]string, tok Token) bool {
+	for _, form := range append([]string{tok.Term}, tok.Variants...) {
+		phrase := words(form)
+		for _, clause := range clauses {
+			if containsPhrase(clause, phrase, func(at int) bool { return r.negated(clause, at) == tok.Absent }) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *Rules) negated(clause []string, at int) bool {
+	window := r.NegationWindow
+	if window == 0 {
+		window = 3
+	}
+	for i := at - 1; i >= 0 && i >= at-window; i-- {
+		for _, neg := range r.Negations {
+			if strings.EqualFold(clause[i], neg) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// clauseWords splits text on clause punctuation so negation does not leak across clauses.
+func clauseWords(text string) [][]string {
+	raw := strings.FieldsFunc(text, func(r rune) bool {
+		return strings.ContainsRune(".,;:!?\n", r)
+	})
+	out := make([][]string, 0, len(raw))
+	for _, c := range raw {
+		if w := words(c); len(w) > 0 {
+			out = append(out, w)
+		}
+	}
+	return out
+}
+
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	})
+}
+
+// containsPhrase reports whether phrase occurs in text at a position accept
+// allows; a nil accept allows any. An empty phrase never occurs.
+func containsPhrase(text, phrase []string, accept func(at int) bool) bool {
+	if len(phrase) == 0 {
+		return false
+	}
+	for i := 0; i+len(phrase) <= len(text); i++ {
+		match := true
+		for j, w := range phrase {
+			if text[i+j] != w {
+				match = false
+				break
+			}
+		}
+		if match && (accept == nil || accept(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+// RuleAgent evaluates seats offline using declarative Rules.
+type RuleAgent struct {
+	Rules *Rules
+}
+
+func NewRuleAgent(rules *Rules) *RuleAgent {
+	if rules == nil {
+		rules = DefaultRules()
+	}
+	return &RuleAgent{Rules: rules}
+}
+
+// Evaluate scores the case for the seat. Multi-option cases also get a ranking
+// of the options. For cases with an outcome schema the
+// seat proposes the rules' value for the case type, or the case's own proposal
+// when the rules are silent; values outside the schema are dropped.
+func (a *RuleAgent) Evaluate(c core.Case, p Perspective) Assessment {
+	scores := a.Rules.Scores(c)
+	out := a.Rules.Evaluate(p.Name, scores)
+	assessment := Assessment{Stance: out.Stance, Reasoning: out.Reasoning, Concerns: out.Concerns, Motion: out.Motion}
+	if len(c.Options) > 0 {
+		assessment.Ranking = a.rankOptions(c, p)
+		assessment.Outcome = assessment.Ranking[0]
+		return assessment
+	}
+	if schema := core.OutcomeSchemaFor(c); schema != nil {
+		value := a.Rules.EvaluateOutcome(c.Type, p.Name, scores)
+		if _, ok := a.Rules.Outcomes[c.Type]; !ok || value == ProposedOutcome {
+			value = proposedOutcome(c, *schema)
+		}
+		if v, err := schema.Normalize(value); err == nil {
+			assessment.Outcome = v
+		}
+	}
+	return assessment
+}
+
+// Review keeps the proposed final position. When that moves the seat off
+// its initial stance, the reasoning names the challengers as the seat was
+// shown them.
+func (a *RuleAgent) Review(c core.Case, p Perspective, r Review) Assessment {
+	out := r.Proposed
+	if out.Stance == r.Initial.Stance || len(r.Challenges) == 0 {
+		return out
+	}
+	var from []string
+	seen := map[string]bool{}
+	for _, ch := range r.Challenges {
+		if !seen[ch.From] {
+			seen[ch.From] = true
+			from = append(from, ch.From)
+		}
+	}
+	out.Reasoning = strings.TrimSpace(fmt.Sprintf("%s Weighed challenges from %s.", out.Reasoning, strings.Join(from, ", ")))
+	return out
+}
@@ -0,0 +1,65 @@
+package deliberation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+func TestRulesScoreUsesWordBoundariesAndNegation(t *testing.T) {
+	rules := DefaultRules()
+	cases := []struct {
+		text string
+		risk float64
+	}{
+		{"Add a dropdown to the settings page", 0},
+		{"This change is not unsafe", 0},
+		{"Drop the legacy table", 1},
+		{"Not urgent, but we should delete the cache", 1},
+		{"Disable checks and bypass review", 2},
+		{"Dropping the legacy table", 1},
+		{"We deleted the old backups", 1},
+		{"Disabling the rate limiter and bypassed the gate", 2},
+		{"There is no rollback plan", 1},
+		{"We don't have a security review", 1},
+		{"Ship it without review", 1},
+		{"Merge it without tests", 1},
+		{"We never roll back deploys", 1},
+		{"The rollout has a rollback plan and a code review", 1},
+		{"We don't drop anything", 0},
+	}
+	for _, tc := range cases {
+		scores := rules.Scores(core.Case{Question: tc.text, Summary: tc.text})
+		if got := scores["risk"]; got != tc.risk {
+			t.Errorf("risk(%q) = %v, want %v", tc.text, got, tc.risk)
+		}
+	}
+}
+
+func TestRuleAgentUsesPerspectiveThresholds(t *testing.T) {
+	agent := NewRuleAgent(nil)
+	c := core.Case{Question: "Should we bypass the security gate?", Summary: "bypass security"}
+	if got := agent.Evaluate(c, Perspective{Name: "pragmatist"}).Stance; got != core.DecisionReject {
+		t.Fatalf("expected pragmatist to reject high-risk case, got %s", got)
+	}
+	if got := agent.Evaluate(c, Perspective{Name: "skeptic"}).Stance; got != core.DecisionDefer {
+		t.Fatalf("expected skeptic to defer evidence-free case, got %s", got)
+	}
+	if got := agent.Evaluate(c, Perspective{Name: "unknown"}).Stance; got != core.DecisionReject {
+		t.Fatalf("expected default rules to reject high-risk case, got %s", got)
+	}
+}
+
+func TestParseRulesRejectsUnknownSignal(t *testing.T) {
+	_, err := ParseRules([]byte(`{
+		"signals": {"risk": {"tokens": ["unsafe", {"term": "drop table", "weight": 2}]}},
+		"default": {
+			"rules": [{"when": {"urgency": {"min": 1}}, "stance": "approved", "reasoning": "go"}],
+			"fallback": {"stance": "deferred", "reasoning": "wait"}
+		}
+	}`))
+	if err == nil || !strings.Contains(err.Error(), `unknown signal "urgency"`) {
+		t.Fatalf("expected unknown signal error, got %v", err)
+	}
+}
@@ -0,0 +1,297 @@
+package deliberation
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// PanelConfig is one named panel configuration under simulation.
+type PanelConfig struct {
+	Name  string
+	Panel []Perspective
+}
+
+// SimulateOptions controls an in-memory simulation.
+type SimulateOptions struct {
+	Runs       int
+	Protocol   string
+	Agent      Agent
+	JudgeModel string
+	Vetoes     []Veto
+	// Baseline is the stored verdict to compare against; when nil the first
+	// configuration's first run is used.
+	Baseline *core.Verdict
+	Now      time.Time
+	// Noise is the chance, per run, that an agent seat's initial stance
+	// moves one step on the rejected-amended-approved scale, so runs of a
+	// deterministic agent such as RuleAgent vary. Seed drives it; run r
+	// draws the same perturbations under every configuration.
+	Noise float64
+	Seed  int64
+}
+
+// SimulationReport compares verdicts across panel configurations.
+type SimulationReport struct {
+	CaseID            string            `json:"case_id"`
+	Runs              int               `json:"runs"`
+	Noise             float64           `json:"noise,omitempty"`
+	Seed              int64             `json:"seed,omitempty"`
+	Baseline          string            `json:"baseline"`
+	BaselineVerdict   core.Decision     `json:"baseline_verdict"`
+	Panels            []PanelSimulation `json:"panels"`
+	PairwiseAgreement []PanelAgreement  `json:"pairwise_agreement,omitempty"`
+}
+
+// PanelSimulation summarizes all runs of one panel configuration.
+type PanelSimulation struct {
+	Panel             string                `json:"panel"`
+	Seats             []string              `json:"seats"`
+	Verdicts          map[core.Decision]int `json:"verdicts"`
+	Modal             core.Decision         `json:"modal_verdict"`
+	Consistency       float64               `json:"consistency"`
+	BaselineAgreement float64               `json:"baseline_agreement"`
+	SeatAgreement     float64               `json:"seat_agreement"`
+	Flips             []SeatFlip            `json:"flips,omitempty"`
+
+	runs []core.Decision
+}
+
+// SeatFlip records a seat whose modal final stance differs from the baseline.
+type SeatFlip struct {
+	Seat string        `json:"seat"`
+	From core.Decision `json:"from"`
+	To   core.Decision `json:"to"`
+}
+
+// PanelAgreement is the run-by-run verdict agreement rate between two panels.
+type PanelAgreement struct {
+	A    string  `json:"a"`
+	B    string  `json:"b"`
+	Rate float64 `json:"rate"`
+}
+
+// Simulate deliberates c under each configuration without persisting anything.
+// Each run rotates seat order so order-sensitive agents see varied orderings;
+// opts.Noise varies the stances themselves.
+func Simulate(c core.Case, configs []PanelConfig, opts SimulateOptions) (SimulationReport, error) {
+	if len(configs) == 0 {
+		return SimulationReport{}, errors.New("at least one panel configuration is required")
+	}
+	if err := c.Validate(); err != nil {
+		return SimulationReport{}, err
+	}
+	runs := opts.Runs
+	if runs <= 0 {
+		runs = 1
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now().UTC()
+	}
+
+	if opts.Noise < 0 || opts.Noise > 1 {
+		return SimulationReport{}, fmt.Errorf("noise %v is outside 0..1", opts.Noise)
+	}
+
+	report := SimulationReport{CaseID: c.ID, Runs: runs, Noise: opts.Noise}
+	if opts.Noise > 0 {
+		report.Seed = opts.Seed
+	}
+	var baseline map[string]core.Decision
+	if opts.Baseline != nil {
+		report.Baseline = "stored"
+		report.BaselineVerdict = opts.Baseline.Verdict
+		baseline, _ = verdictSeats(*opts.Baseline)
+	}
+
+	seatRuns := make([]map[string]map[core.Decision]int, len(configs))
+	for i, cfg := range configs {
+		if len(cfg.Panel) == 0 {
+			return SimulationReport{}, fmt.Errorf("panel %q has no seats", cfg.Name)
+		}
+		sim := PanelSimulation{
+			Panel:    cfg.Name,
+			Verdicts: map[core.Decision]int{},
+		}
+		for _, p := range cfg.Panel {
+			sim.Seats = append(sim.Seats, p.Name)
+		}
+		seatRuns[i] = map[string]map[core.Decision]int{}
+		agreeing, seats := 0, 0
+		for r := 0; r < runs; r++ {
+			engine := New(rotatePanel(cfg.Panel, r))
+			if opts.Agent != nil {
+				engine.Agent = opts.Agent
+			}
+			if opts.Noise > 0 {
+				engine.Agent = noisyAgent{Agent: engine.Agent, noise: opts.Noise, rng: rand.New(rand.NewPCG(uint64(opts.Seed), uint64(r)))}
+			}
+			engine.Vetoes = opts.Vetoes
+			engine.Protocol = opts.Protocol
+			if opts.JudgeModel != "" {
+				engine.JudgeModel = opts.JudgeModel
+			}
+			_, verdict, err := engine.Deliberate(c, now)
+			if err != nil {
+				return SimulationReport{}, fmt.Errorf("panel %q run %d: %w", cfg.Name, r+1, err)
+			}
+			if baseline == nil {
+				report.Baseline = cfg.Name
+				report.BaselineVerdict = verdict.Verdict
+				baseline, _ = verdictSeats(verdict)
+			}
+			sim.Verdicts[verdict.Verdict]++
+			sim.runs = append(sim.runs, verdict.Verdict)
+			stances, decided := verdictSeats(verdict)
+			for seat, stance := range stances {
+				if seatRuns[i][seat] == nil {
+					seatRuns[i][seat] = map[core.Decision]int{}
+				}
+				seatRuns[i][seat][stance]++
+				if stance == decided[seat] {
+					agreeing++
+				}
+				seats++
+			}
+		}
+		sim.Modal = majorityDecision(sim.Verdicts)
+		if sim.Modal == "" {
+			sim.Modal = core.DecisionDefer
+		}
+		sim.Consistency = ratio(sim.Verdicts[sim.Modal], runs)
+		sim.SeatAgreement = ratio(agreeing, seats)
+		report.Panels = append(report.Panels, sim)
+	}
+	for i := range report.Panels {
+		sim := &report.Panels[i]
+		sim.BaselineAgreement = ratio(sim.Verdicts[report.BaselineVerdict], runs)
+		for _, seat := range sortedSeats(seatRuns[i]) {
+			from, ok := baseline[seat]
+			if !ok {
+				continue
+			}
+			to := modalStance(seatRuns[i][seat])
+			if to != from {
+				sim.Flips = append(sim.Flips, SeatFlip{Seat: seat, From: from, To: to})
+			}
+		}
+	}
+
+	for i := 0; i < len(report.Panels); i++ {
+		for j := i + 1; j < len(report.Panels); j++ {
+			a, b := report.Panels[i], report.Panels[j]
+			same := 0
+			for r := 0; r < runs; r++ {
+				if a.runs[r] == b.runs[r] {
+					same++
+				}
+			}
+			report.PairwiseAgreement = append(report.PairwiseAgreement, PanelAgreement{A: a.Panel, B: b.Panel, Rate: ratio(same, runs)})
+		}
+	}
+	return report, nil
+}
+
+// seatStances keys final stances by perspective, suffixing repeats ("skeptic#2")
+// so seats can be matched across panels with different agent IDs.
+func seatStances(positions []core.Position) map[string]core.Decision {
+	out := make(map[string]core.Decision, len(positions))
+	seen := map[string]int{}
+	for _, p := range positions {
+		seen[p.Perspective]++
+		key := p.Perspective
+		if n := seen[p.Perspective]; n > 1 {
+			key = fmt.Sprintf("%s#%d", p.Perspective, n)
+		}
+		out[key] = p.Stance
+	}
+	return out
+}
+
+// verdictSeats returns each seat's final stance and the decision it is
+// measured against. Seats on sub-questions are keyed "<item>/<seat>".
+func verdictSeats(v core.Verdict) (map[string]core.Decision, map[string]core.Decision) {
+	stances := map[string]core.Decision{}
+	decided := map[string]core.Decision{}
+	if len(v.Items) == 0 {
+		for seat, stance := range seatStances(v.FinalPositions) {
+			stances[seat] = stance
+			decided[seat] = v.Verdict
+		}
+		return stances, decided
+	}
+	for _, it := range v.Items {
+		for seat, stance := range seatStances(it.FinalPositions) {
+			stances[it.ItemID+"/"+seat] = stance
+			decided[it.ItemID+"/"+seat] = it.Verdict
+		}
+	}
+	return stances, decided
+}
+
+// noisyAgent moves an agent's stance one step with probability noise:
+// approved or rejected to amended, amended or deferred to either side.
+// Abstentions are kept.
+type noisyAgent struct {
+	Agent
+	noise float64
+	rng   *rand.Rand
+}
+
+func (a noisyAgent) Evaluate(c core.Case, p Perspective) Assessment {
+	out := a.Agent.Evaluate(c, p)
+	if a.rng.Float64() >= a.noise {
+		return out
+	}
+	sides := []core.Decision{core.DecisionReject, core.DecisionApprove}
+	switch out.Stance {
+	case core.DecisionApprove, core.DecisionReject:
+		out.Stance = core.DecisionAmend
+	case core.DecisionAmend, core.DecisionDefer:
+		out.Stance = sides[a.rng.IntN(2)]
+	default:
+		return out
+	}
+	out.Reasoning = fmt.Sprintf("%s (Simulated variation: moved to %s.)", out.Reasoning, out.Stance)
+	return out
+}
+
+func rotatePanel(panel []Perspective, by int) []Perspective {
+	n := len(panel)
+	out := make([]Perspective, n)
+	for i := range panel {
+		out[i] = panel[(i+by)%n]
+	}
+	return out
+}
+
+func modalStance(counts map[core.Decision]int) core.Decision {
+	var best core.Decision
+	for _, d := range []core.Decision{core.DecisionApprove, core.DecisionReject, core.DecisionAmend, core.DecisionDefer} {
+		if counts[d] > counts[best] {
+			best = d
+		}
+	}
+	return best
+}
+
+func sortedSeats(m map[string]map[core.Decision]int) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func ratio(n, d int) float64 {
+	if d == 0 {
+		return 0
+	}
+	return float64(n) / float64(d)
+}
@@ -0,0 +1,104 @@
+package deliberation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+func TestSimulateComparesPanelsAgainstStoredBaseline(t *testing.T) {
+	c := core.Case{
+		ID:       "senate-7",
+		Type:     "gate_criteria",
+		Summary:  "Raise coverage threshold",
+		Question: "Should Centurion require 70% coverage?",
+		Evidence: []string{"coverage report"},
+		FiledAt:  time.Now().UTC().Format(time.RFC3339),
+	}
+	cautious, err := ParsePanelSpec("cautious", nil)
+	if err != nil {
+		t.Fatalf("parse preset: %v", err)
+	}
+	custom, err := ParsePanelSpec("pragmatist+pragmatist", nil)
+	if err != nil {
+		t.Fatalf("parse custom spec: %v", err)
+	}
+	baseline := core.Verdict{
+		Verdict: core.DecisionApprove,
+		FinalPositions: []core.Position{
+			{Perspective: "purist", Stance: core.DecisionApprove},
+			{Perspective: "pragmatist", Stance: core.DecisionAmend},
+		},
+	}
+
+	report, err := Simulate(c, []PanelConfig{
+		{Name: "cautious", Panel: cautious},
+		{Name: "custom", Panel: custom},
+	}, SimulateOptions{Runs: 3, Baseline: &baseline})
+	if err != nil {
+		t.Fatalf("simulate: %v", err)
+	}
+	if report.Baseline != "stored" || report.BaselineVerdict != core.DecisionApprove {
+		t.Fatalf("unexpected baseline: %s %s", report.Baseline, report.BaselineVerdict)
+	}
+	if len(report.Panels) != 2 || len(report.PairwiseAgreement) != 1 {
+		t.Fatalf("expected 2 panels and 1 pairwise rate, got %d and %d", len(report.Panels), len(report.PairwiseAgreement))
+	}
+	first := report.Panels[0]
+	if first.Verdicts[core.DecisionAmend] != 3 || first.Consistency != 1 {
+		t.Fatalf("expected cautious panel to amend on every run, got %+v", first.Verdicts)
+	}
+	if first.BaselineAgreement != 0 {
+		t.Fatalf("expected no agreement with approved baseline, got %v", first.BaselineAgreement)
+	}
+	if len(first.Flips) != 1 || first.Flips[0].Seat != "purist" || first.Flips[0].To != core.DecisionAmend {
+		t.Fatalf("expected purist flip to amended, got %+v", first.Flips)
+	}
+	if len(report.Panels[1].Seats) != 2 {
+		t.Fatalf("expected custom panel with 2 seats, got %v", report.Panels[1].Seats)
+	}
+}
+
+func TestSimulateNoiseVariesRunsReproducibly(t *testing.T) {
+	c := core.Case{
+		ID:       "senate-8",
+		Type:     "architecture",
+		Summary:  "Split the scheduler",
+		Question: "Should the scheduler move into its own service?",
+		FiledAt:  time.Now().UTC().Format(time.RFC3339),
+	}
+	panel, err := ParsePanelSpec("default", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configs := []PanelConfig{{Name: "default", Panel: panel}}
+
+	steady, err := Simulate(c, configs, SimulateOptions{Runs: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if steady.Panels[0].Consistency != 1 {
+		t.Fatalf("expected identical runs without noise, got %+v", steady.Panels[0].Verdicts)
+	}
+
+	opts := SimulateOptions{Runs: 20, Noise: 0.5, Seed: 11}
+	noisy, err := Simulate(c, configs, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(noisy.Panels[0].Verdicts) < 2 || noisy.Panels[0].Consistency == 1 {
+		t.Fatalf("expected noise to vary the runs, got %+v", noisy.Panels[0].Verdicts)
+	}
+	again, err := Simulate(c, configs, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(noisy.Panels[0].Verdicts, again.Panels[0].Verdicts) {
+		t.Fatalf("expected the seed to reproduce the runs, got %+v and %+v", noisy.Panels[0].Verdicts, again.Panels[0].Verdicts)
+	}
+	if _, err := Simulate(c, configs, SimulateOptions{Noise: 1.5}); err == nil {
+		t.Fatal("expected noise above 1 to be refused")
+	}
+}
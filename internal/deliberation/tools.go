@@ -0,0 +1,118 @@
+package deliberation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// DefaultToolBudget is the number of tool calls each seat may make per case.
+const DefaultToolBudget = 3
+
+// ErrToolBudget is returned once a seat has spent its tool-call budget.
+var ErrToolBudget = errors.New("tool-call budget exhausted")
+
+// Tool is a read-only capability a seat may call while forming a position.
+// Call receives the case under deliberation so tools can scope themselves to it.
+type Tool interface {
+	Name() string
+	Description() string
+	Call(c core.Case, args map[string]string) (string, error)
+}
+
+// ToolAgent is an Agent that can call tools. The engine uses EvaluateWithTools
+// instead of Evaluate when the engine has tools configured.
+type ToolAgent interface {
+	Agent
+	EvaluateWithTools(c core.Case, p Perspective, tools *ToolSession) Assessment
+}
+
+// ToolSession gives one seat access to the engine's tools within its budget
+// and logs every call, refused ones included.
+type ToolSession struct {
+	seat   core.PanelMember
+	round  string
+	c      core.Case
+	tools  map[string]Tool
+	budget int
+	used   *int
+	log    *[]core.ToolCall
+}
+
+// Names lists the available tool names in sorted order.
+func (s *ToolSession) Names() []string {
+	names := make([]string, 0, len(s.tools))
+	for name := range s.tools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Remaining is the number of calls left in the seat's budget.
+func (s *ToolSession) Remaining() int {
+	return s.budget - *s.used
+}
+
+// Call invokes a tool by name, spending one call of the seat's budget.
+func (s *ToolSession) Call(name string, args map[string]string) (string, error) {
+	record := core.ToolCall{AgentID: s.seat.AgentID, Round: s.round, Tool: name, Args: args}
+	result, err := s.call(name, args)
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.Result = result
+	}
+	*s.log = append(*s.log, record)
+	return result, err
+}
+
+func (s *ToolSession) call(name string, args map[string]string) (string, error) {
+	tool, ok := s.tools[name]
+	if !ok {
+		return "", fmt.Errorf("unknown tool %q", name)
+	}
+	if *s.used >= s.budget {
+		return "", ErrToolBudget
+	}
+	*s.used++
+	return tool.Call(s.c, args)
+}
+
+// toolSession opens a session for seat, tracking its budget across rounds
+// and questions of the current deliberation.
+func (e *Engine) toolSession(c core.Case, seat core.PanelMember, round string, log *[]core.ToolCall) *ToolSession {
+	if e.toolUse == nil {
+		e.toolUse = map[string]*int{}
+	}
+	used, ok := e.toolUse[seat.AgentID]
+	if !ok {
+		used = new(int)
+		e.toolUse[seat.AgentID] = used
+	}
+	budget := e.ToolBudget
+	if budget <= 0 {
+		budget = DefaultToolBudget
+	}
+	tools := make(map[string]Tool, len(e.Tools))
+	for _, t := range e.Tools {
+		tools[t.Name()] = t
+	}
+	return &ToolSession{seat: seat, round: round, c: c, tools: tools, budget: budget, used: used, log: log}
+}
+
+// EvaluateWithTools evaluates like Evaluate, then searches precedent for the
+// case and cites up to two related verdicts in the seat's concerns.
+func (a *RuleAgent) EvaluateWithTools(c core.Case, p Perspective, tools *ToolSession) Assessment {
+	assessment := a.Evaluate(c, p)
+	result, err := tools.Call("search_precedents", map[string]string{"query": c.Summary, "limit": "2"})
+	if err != nil || strings.TrimSpace(result) == "" {
+		return assessment
+	}
+	note := "Related precedent: " + strings.Join(strings.Split(strings.TrimSpace(result), "\n"), "; ") + "."
+	assessment.Concerns = strings.TrimSpace(assessment.Concerns + " " + note)
+	return assessment
+}
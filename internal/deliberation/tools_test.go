@@ -0,0 +1,60 @@
+package deliberation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+type echoTool struct{}
+
+func (echoTool) Name() string        { return "search_precedents" }
+func (echoTool) Description() string { return "echo" }
+func (echoTool) Call(c core.Case, args map[string]string) (string, error) {
+	return "senate-1 (approved): " + args["query"], nil
+}
+
+// greedyAgent calls the only tool until its budget runs out.
+type greedyAgent struct{ *RuleAgent }
+
+func (a greedyAgent) EvaluateWithTools(c core.Case, p Perspective, tools *ToolSession) Assessment {
+	for {
+		if _, err := tools.Call("search_precedents", map[string]string{"query": c.Summary}); errors.Is(err, ErrToolBudget) {
+			break
+		}
+	}
+	return a.Evaluate(c, p)
+}
+
+func TestToolCallsAreBudgetedAndLogged(t *testing.T) {
+	engine := New(BuildPanel(2, nil, nil))
+	engine.Agent = greedyAgent{NewRuleAgent(nil)}
+	engine.Tools = []Tool{echoTool{}}
+	engine.ToolBudget = 2
+	transcript, _, err := engine.Deliberate(protocolCase(), time.Now().UTC())
+	if err != nil {
+		t.Fatalf("deliberate: %v", err)
+	}
+	// Each seat makes two calls and one refused call.
+	if len(transcript.ToolCalls) != 6 {
+		t.Fatalf("expected 6 logged calls, got %d", len(transcript.ToolCalls))
+	}
+	refused := transcript.ToolCalls[2]
+	if refused.Error != ErrToolBudget.Error() || refused.AgentID != "agent-1" || refused.Round != core.RoundInitial {
+		t.Fatalf("expected agent-1's third call refused, got %+v", refused)
+	}
+}
+
+func TestRuleAgentCitesPrecedentWithTools(t *testing.T) {
+	engine := New(BuildPanel(1, nil, nil))
+	engine.Tools = []Tool{echoTool{}}
+	transcript, _, err := engine.Deliberate(protocolCase(), time.Now().UTC())
+	if err != nil {
+		t.Fatalf("deliberate: %v", err)
+	}
+	if len(transcript.ToolCalls) != 1 || transcript.InitialPositions[0].Concerns == "" {
+		t.Fatalf("expected one precedent lookup cited in concerns, got %+v", transcript.InitialPositions[0])
+	}
+}
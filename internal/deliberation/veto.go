@@ -0,0 +1,99 @@
+package deliberation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// Veto gives a seat power to block one verdict outcome.
+type Veto struct {
+	// Seat is the perspective name holding the veto.
+	Seat string
+	// Blocks is the verdict the seat may veto.
+	Blocks core.Decision
+	// Fallback replaces a vetoed verdict.
+	Fallback core.Decision
+	// Topics restricts the veto to cases mentioning one of these terms;
+	// empty means the veto always applies.
+	Topics []string
+}
+
+// ParseVetoSpec parses "<seat>:<decision>[:<fallback>][@<topic>+<topic>]",
+// e.g. "skeptic:approved:amended@security+auth". Fallback defaults to deferred.
+func ParseVetoSpec(spec string) (Veto, error) {
+	spec = strings.TrimSpace(spec)
+	body, topics, _ := strings.Cut(spec, "@")
+	parts := strings.Split(body, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Veto{}, fmt.Errorf("veto %q must be <seat>:<decision>[:<fallback>][@topics]", spec)
+	}
+	v := Veto{
+		Seat:     strings.TrimSpace(parts[0]),
+		Blocks:   core.ParseDecision(parts[1]),
+		Fallback: core.DecisionDefer,
+	}
+	if len(parts) == 3 {
+		v.Fallback = core.ParseDecision(parts[2])
+	}
+	for _, t := range strings.Split(topics, "+") {
+		if clean := strings.TrimSpace(t); clean != "" {
+			v.Topics = append(v.Topics, clean)
+		}
+	}
+	if v.Seat == "" {
+		return Veto{}, fmt.Errorf("veto %q names no seat", spec)
+	}
+	if err := v.Blocks.Validate(); err != nil {
+		return Veto{}, fmt.Errorf("veto %q: %w", spec, err)
+	}
+	if err := v.Fallback.Validate(); err != nil {
+		return Veto{}, fmt.Errorf("veto %q fallback: %w", spec, err)
+	}
+	if v.Fallback == v.Blocks {
+		return Veto{}, fmt.Errorf("veto %q fallback must differ from the vetoed decision", spec)
+	}
+	return v, nil
+}
+
+func (v Veto) covers(c core.Case) bool {
+	if len(v.Topics) == 0 {
+		return true
+	}
+	text := words(strings.Join([]string{c.Question, c.Summary, c.RequestedDecision}, " "))
+	for _, topic := range v.Topics {
+		if containsPhrase(text, words(topic), nil) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyVetoes converts the verdict to a veto's fallback when a seat holding
+// that veto opposed the outcome in its final position. At most one veto applies.
+func applyVetoes(c core.Case, verdict core.Verdict, vetoes []Veto) (core.Verdict, []core.VetoRecord) {
+	for _, v := range vetoes {
+		if verdict.Verdict != v.Blocks || !v.covers(c) {
+			continue
+		}
+		for _, p := range verdict.FinalPositions {
+			if p.Perspective != v.Seat || p.Stance == v.Blocks || p.Stance == core.DecisionAbstain {
+				continue
+			}
+			record := core.VetoRecord{
+				AgentID:     p.AgentID,
+				Perspective: p.Perspective,
+				Blocked:     v.Blocks,
+				Fallback:    v.Fallback,
+				Reasoning:   p.Reasoning,
+			}
+			verdict.Verdict = v.Fallback
+			verdict.Binding = v.Fallback != core.DecisionDefer
+			verdict.Implementation = buildImplementationText(c, v.Fallback)
+			verdict.Reasoning = strings.TrimSpace(fmt.Sprintf("%s Vetoed by %s (%s): %s", verdict.Reasoning, p.AgentID, p.Perspective, p.Reasoning))
+			return verdict, []core.VetoRecord{record}
+		}
+	}
+	return verdict, nil
+}
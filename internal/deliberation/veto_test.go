@@ -0,0 +1,45 @@
+package deliberation
+
+import (
+	"testing"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+func TestParseVetoSpec(t *testing.T) {
+	v, err := ParseVetoSpec("skeptic:approve:amended@security+auth flow")
+	if err != nil {
+		t.Fatalf("parse veto: %v", err)
+	}
+	if v.Seat != "skeptic" || v.Blocks != core.DecisionApprove || v.Fallback != core.DecisionAmend || len(v.Topics) != 2 {
+		t.Fatalf("unexpected veto: %+v", v)
+	}
+	if _, err := ParseVetoSpec("skeptic:approved:approved"); err == nil {
+		t.Fatal("expected error when fallback equals vetoed decision")
+	}
+}
+
+func TestApplyVetoesConvertsVerdictOnCoveredTopic(t *testing.T) {
+	veto := Veto{Seat: "skeptic", Blocks: core.DecisionApprove, Fallback: core.DecisionDefer, Topics: []string{"security"}}
+	verdict := core.Verdict{
+		Verdict: core.DecisionApprove,
+		Binding: true,
+		FinalPositions: []core.Position{
+			{AgentID: "agent-1", Perspective: "pragmatist", Stance: core.DecisionApprove},
+			{AgentID: "agent-2", Perspective: "skeptic", Stance: core.DecisionReject, Reasoning: "Unmitigated risk."},
+		},
+	}
+
+	out, records := applyVetoes(core.Case{Question: "Relax the dropdown styling"}, verdict, []Veto{veto})
+	if len(records) != 0 || out.Verdict != core.DecisionApprove {
+		t.Fatalf("expected veto not to apply off-topic, got %s %+v", out.Verdict, records)
+	}
+
+	out, records = applyVetoes(core.Case{Question: "Relax the security gate"}, verdict, []Veto{veto})
+	if len(records) != 1 || records[0].AgentID != "agent-2" {
+		t.Fatalf("expected skeptic veto record, got %+v", records)
+	}
+	if out.Verdict != core.DecisionDefer || out.Binding {
+		t.Fatalf("expected non-binding deferred verdict, got %s binding=%t", out.Verdict, out.Binding)
+	}
+}
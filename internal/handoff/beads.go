@@ -14,7 +14,7 @@ import (
 
 var beadIDPattern = regexp.MustCompile(`([A-Za-z0-9]+-[A-Za-z0-9][A-Za-z0-9-]*)`)
 
-// Runner executes external commands (bd create).
+// Runner executes external commands (bd create, bd close).
 type Runner interface {
 	Run(ctx context.Context, name string, args []string, dir string) (string, error)
 }
@@ -51,7 +51,11 @@ func CreateBeadForVerdict(ctx context.Context, runner Runner, workspaceDir strin
 
 	target := inferTargetSystem(verdict.Type)
 	title := fmt.Sprintf("[%s] Senate %s: %s", target, verdict.CaseID, trimTo(verdict.Summary, 80))
-	description := strings.TrimSpace(fmt.Sprintf("Binding Senate verdict for case %s\n\nVerdict: %s\nReasoning: %s\nImplementation: %s\n", verdict.CaseID, verdict.Verdict, verdict.Reasoning, verdict.Implementation))
+	decision := string(verdict.Verdict)
+	if verdict.Outcome != "" {
+		decision += " (" + verdict.Outcome + ")"
+	}
+	description := strings.TrimSpace(fmt.Sprintf("Binding Senate verdict for case %s\n\nVerdict: %s\nReasoning: %s\nImplementation: %s\n", verdict.CaseID, decision, verdict.Reasoning, verdict.Implementation))
 
 	args := []string{"create", "--title", title, "--priority", "2", "--description", description, "--silent"}
 	out, err := runner.Run(ctx, "bd", args, workspaceDir)
@@ -67,6 +71,22 @@ func CreateBeadForVerdict(ctx context.Context, runner Runner, workspaceDir strin
 	return Result{BeadID: beadID, Title: title, Status: "created"}, nil
 }
 
+// CloseBead closes a bead whose work no longer stands, such as one created
+// for a provisional verdict the full panel overturned.
+func CloseBead(ctx context.Context, runner Runner, workspaceDir, beadID, reason string) error {
+	if runner == nil {
+		runner = execRunner{}
+	}
+	if workspaceDir == "" {
+		workspaceDir = defaultWorkspaceDir()
+	}
+	out, err := runner.Run(ctx, "bd", []string{"close", beadID, "--reason", reason}, workspaceDir)
+	if err != nil {
+		return fmt.Errorf("bd close %s failed: %w (%s)", beadID, err, strings.TrimSpace(out))
+	}
+	return nil
+}
+
 func parseBeadID(out string) string {
 	clean := strings.TrimSpace(out)
 	if clean == "" {
@@ -69,6 +69,19 @@ func TestCreateBeadForVerdictError(t *testing.T) {
 	}
 }
 
+func TestCloseBead(t *testing.T) {
+	r := &fakeRunner{}
+	if err := CloseBead(context.Background(), r, "/tmp/workspace", "athena-xyz", "overturned"); err != nil {
+		t.Fatalf("close bead: %v", err)
+	}
+	if r.name != "bd" || len(r.args) != 4 || r.args[0] != "close" || r.args[1] != "athena-xyz" {
+		t.Fatalf("expected bd close athena-xyz, got %s %v", r.name, r.args)
+	}
+	if err := CloseBead(context.Background(), &fakeRunner{err: errors.New("boom")}, "", "athena-xyz", "overturned"); err == nil {
+		t.Fatal("expected bd failure to be returned")
+	}
+}
+
 func TestParseBeadID(t *testing.T) {
 	if got := parseBeadID("Created issue: athena-123"); got != "athena-123" {
 		t.Fatalf("unexpected bead id: %q", got)
@@ -0,0 +1,221 @@
+// Package ledger keeps a tamper-evident record of what Senate issued. Every
+// verdict, transcript and precedent record it writes is hashed into an
+// append-only chain in which each entry commits to the one before it, so a
+// document edited or deleted by hand, or an entry removed from the chain,
+// no longer matches on verification.
+package ledger
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/precedent"
+)
+
+// Document kinds recorded in the ledger.
+const (
+	KindVerdict    = "verdict"
+	KindTranscript = "transcript"
+	KindPrecedent  = "precedent"
+)
+
+// ReasonAdopted marks an entry senate migrate recorded for a document
+// found in state rather than issued through Senate; Verify lists them.
+const ReasonAdopted = "adopted"
+
+// Entry is one link of the chain. Hash covers every other field, including
+// PrevHash, the Hash of the entry before it ("" for the first).
+type Entry struct {
+	Seq        int    `json:"seq"`
+	Kind       string `json:"kind"`
+	CaseID     string `json:"case_id"`
+	DocHash    string `json:"doc_hash"`
+	Reason     string `json:"reason,omitempty"`
+	RecordedAt string `json:"recorded_at"`
+	PrevHash   string `json:"prev_hash"`
+	Hash       string `json:"hash"`
+}
+
+// Hash returns the hex sha256 of a document's core.Canonical encoding, so
+// a schema migration or a re-encoding by another build does not read as an
+// alteration.
+func Hash(doc any) (string, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	if data, err = core.Canonical(data); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Next builds the entry that records a document after prev, the current
+// last entry (nil for an empty ledger).
+func Next(prev *Entry, kind, caseID string, doc any, reason string, now time.Time) (Entry, error) {
+	docHash, err := Hash(doc)
+	if err != nil {
+		return Entry{}, err
+	}
+	e := Entry{Seq: 1, Kind: kind, CaseID: caseID, DocHash: docHash, Reason: reason, RecordedAt: now.UTC().Format(time.RFC3339)}
+	if prev != nil {
+		e.Seq = prev.Seq + 1
+		e.PrevHash = prev.Hash
+	}
+	e.Hash = e.seal()
+	return e, nil
+}
+
+// seal computes the entry's chain hash.
+func (e Entry) seal() string {
+	e.Hash = ""
+	data, _ := json.Marshal(e)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Source is the stored state a ledger vouches for; store.Store satisfies it.
+type Source interface {
+	VerdictIDs() ([]string, error)
+	LoadVerdict(caseID string) (core.Verdict, error)
+	TranscriptIDs() ([]string, error)
+	LoadTranscript(caseID string) (core.Transcript, error)
+	LoadPrecedents() ([]precedent.Record, error)
+}
+
+// Problem is one finding of Verify. DocHash is the stored document's
+// current hash, for altered and unrecorded documents.
+type Problem struct {
+	Seq     int    `json:"seq,omitempty"`
+	Kind    string `json:"kind"`
+	CaseID  string `json:"case_id,omitempty"`
+	Issue   string `json:"issue"`
+	DocHash string `json:"doc_hash,omitempty"`
+}
+
+// Issues reported by Verify.
+const (
+	IssueChainBroken = "chain_broken" // an entry was altered, removed or reordered
+	IssueAltered     = "altered"      // the document differs from its last recorded hash
+	IssueDeleted     = "deleted"      // a recorded document is gone
+	IssueUnrecorded  = "unrecorded"   // a document the ledger never recorded
+)
+
+// Report is the result of Verify.
+type Report struct {
+	Entries     int       `json:"entries"`
+	Verdicts    int       `json:"verdicts"`
+	Transcripts int       `json:"transcripts"`
+	Precedents  int       `json:"precedents"`
+	Problems    []Problem `json:"problems"`
+	// Adopted are the entries recording documents senate migrate adopted,
+	// which the ledger vouches for only from the time of adoption.
+	Adopted []Entry `json:"adopted"`
+}
+
+// OK reports whether verification found nothing wrong.
+func (r Report) OK() bool { return len(r.Problems) == 0 }
+
+// Verify recomputes the chain and checks every stored verdict, transcript
+// and precedent record against the last hash recorded for it.
+func Verify(entries []Entry, src Source) (Report, error) {
+	r := Report{Entries: len(entries), Problems: []Problem{}, Adopted: []Entry{}}
+	// A break is reported at the entry where it shows; checking resumes
+	// from that entry so one removal is not reported for every later one.
+	prev, prevSeq := "", 0
+	latest := map[string]map[string]Entry{KindVerdict: {}, KindTranscript: {}}
+	precedents := map[string][]Entry{}
+	for _, e := range entries {
+		if e.Seq != prevSeq+1 || e.PrevHash != prev || e.seal() != e.Hash {
+			r.Problems = append(r.Problems, Problem{Seq: e.Seq, Kind: e.Kind, CaseID: e.CaseID, Issue: IssueChainBroken})
+		}
+		prev, prevSeq = e.Hash, e.Seq
+		if e.Reason == ReasonAdopted {
+			r.Adopted = append(r.Adopted, e)
+		}
+		if e.Kind == KindPrecedent {
+			precedents[e.DocHash] = append(precedents[e.DocHash], e)
+		} else if byCase, ok := latest[e.Kind]; ok {
+			byCase[e.CaseID] = e
+		}
+	}
+
+	var err error
+	if r.Verdicts, err = check(&r, KindVerdict, latest[KindVerdict], src.VerdictIDs, func(id string) (any, error) { return src.LoadVerdict(id) }); err != nil {
+		return r, err
+	}
+	if r.Transcripts, err = check(&r, KindTranscript, latest[KindTranscript], src.TranscriptIDs, func(id string) (any, error) { return src.LoadTranscript(id) }); err != nil {
+		return r, err
+	}
+
+	// The precedent index is append-only, so records are matched by hash:
+	// each recorded hash must still be present once per time it was added.
+	records, err := src.LoadPrecedents()
+	if err != nil {
+		return r, err
+	}
+	r.Precedents = len(records)
+	for _, rec := range records {
+		h, err := Hash(rec)
+		if err != nil {
+			return r, err
+		}
+		if len(precedents[h]) == 0 {
+			r.Problems = append(r.Problems, Problem{Kind: KindPrecedent, CaseID: rec.CaseID, Issue: IssueUnrecorded, DocHash: h})
+			continue
+		}
+		precedents[h] = precedents[h][1:]
+	}
+	missing := []Entry{}
+	for _, left := range precedents {
+		missing = append(missing, left...)
+	}
+	sort.Slice(missing, func(i, j int) bool { return missing[i].Seq < missing[j].Seq })
+	for _, e := range missing {
+		r.Problems = append(r.Problems, Problem{Seq: e.Seq, Kind: KindPrecedent, CaseID: e.CaseID, Issue: IssueDeleted})
+	}
+	return r, nil
+}
+
+func check(r *Report, kind string, recorded map[string]Entry, list func() ([]string, error), load func(string) (any, error)) (int, error) {
+	ids, err := list()
+	if err != nil {
+		return 0, err
+	}
+	present := map[string]bool{}
+	for _, id := range ids {
+		present[id] = true
+		doc, err := load(id)
+		if err != nil {
+			return 0, fmt.Errorf("load %s %s: %w", kind, id, err)
+		}
+		h, err := Hash(doc)
+		if err != nil {
+			return 0, err
+		}
+		e, ok := recorded[id]
+		switch {
+		case !ok:
+			r.Problems = append(r.Problems, Problem{Kind: kind, CaseID: id, Issue: IssueUnrecorded, DocHash: h})
+		case e.DocHash != h:
+			r.Problems = append(r.Problems, Problem{Seq: e.Seq, Kind: kind, CaseID: id, Issue: IssueAltered, DocHash: h})
+		}
+	}
+	missing := []Entry{}
+	for id, e := range recorded {
+		if !present[id] {
+			missing = append(missing, e)
+		}
+	}
+	sort.Slice(missing, func(i, j int) bool { return missing[i].Seq < missing[j].Seq })
+	for _, e := range missing {
+		r.Problems = append(r.Problems, Problem{Seq: e.Seq, Kind: kind, CaseID: e.CaseID, Issue: IssueDeleted})
+	}
+	return len(ids), nil
+}
@@ -0,0 +1,111 @@
+package ledger_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/ledger"
+	"github.com/Perttulands/senate/internal/precedent"
+	"github.com/Perttulands/senate/internal/store"
+)
+
+var now = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// issue stores a verdict, its transcript and precedent in s and returns the
+// ledger entries recording them.
+func issue(t *testing.T, s store.Store, caseID string, prev *ledger.Entry) []ledger.Entry {
+	t.Helper()
+	at := now.Format(time.RFC3339)
+	v := core.Verdict{CaseID: caseID, FiledAt: at, VerdictAt: at, Type: "architecture", Summary: "Adopt the cache", Verdict: core.DecisionApprove, Reasoning: "sound", Judge: "judge"}
+	tr := core.Transcript{CaseID: caseID}
+	rec := precedent.FromVerdict(v)
+	if err := s.SaveVerdict(v); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SaveTranscript(tr); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AddPrecedent(rec); err != nil {
+		t.Fatal(err)
+	}
+	var out []ledger.Entry
+	for _, doc := range []struct {
+		kind string
+		doc  any
+	}{{ledger.KindVerdict, v}, {ledger.KindTranscript, tr}, {ledger.KindPrecedent, rec}} {
+		e, err := ledger.Next(prev, doc.kind, caseID, doc.doc, "issued", now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, e)
+		prev = &out[len(out)-1]
+	}
+	return out
+}
+
+func TestVerifyAcceptsUntouchedState(t *testing.T) {
+	s := store.NewMemory()
+	entries := issue(t, s, "senate-a", nil)
+	entries = append(entries, issue(t, s, "senate-b", &entries[len(entries)-1])...)
+	r, err := ledger.Verify(entries, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.OK() || r.Entries != 6 || r.Verdicts != 2 || r.Transcripts != 2 || r.Precedents != 2 {
+		t.Fatalf("expected a clean report, got %+v", r)
+	}
+}
+
+func TestVerifyReportsAlteredDocumentsAndBrokenChain(t *testing.T) {
+	s := store.NewMemory()
+	entries := issue(t, s, "senate-a", nil)
+	entries = append(entries, issue(t, s, "senate-b", &entries[len(entries)-1])...)
+
+	v, err := s.LoadVerdict("senate-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v.Verdict = core.DecisionReject
+	if err := s.ReplaceVerdict(v); err != nil {
+		t.Fatal(err)
+	}
+	// Dropping an entry from the middle breaks the chain at the next one.
+	entries = append(entries[:3], entries[4:]...)
+
+	r, err := ledger.Verify(entries, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		ledger.IssueAltered:     ledger.KindVerdict,
+		ledger.IssueChainBroken: ledger.KindTranscript,
+		ledger.IssueUnrecorded:  ledger.KindVerdict,
+	}
+	if len(r.Problems) != len(want) {
+		t.Fatalf("expected %d problems, got %+v", len(want), r.Problems)
+	}
+	for _, p := range r.Problems {
+		if want[p.Issue] != p.Kind {
+			t.Fatalf("unexpected problem %+v", p)
+		}
+	}
+}
+
+func TestVerifyReportsDeletedDocuments(t *testing.T) {
+	s := store.NewMemory()
+	entries := issue(t, s, "senate-a", nil)
+	// The same entries against an empty store: everything was deleted.
+	r, err := ledger.Verify(entries, store.NewMemory())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Problems) != 3 {
+		t.Fatalf("expected three deletions, got %+v", r.Problems)
+	}
+	for _, p := range r.Problems {
+		if p.Issue != ledger.IssueDeleted || p.CaseID != "senate-a" {
+			t.Fatalf("unexpected problem %+v", p)
+		}
+	}
+}
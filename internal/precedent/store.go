@@ -3,6 +3,7 @@ package precedent
 import (
 	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -15,10 +16,17 @@ import (
 
 // Record is one searchable Senate verdict precedent.
 type Record struct {
-	CaseID         string        `json:"case_id"`
+	// SchemaVersion is the version the record was stored at (see
+	// core.SchemaVersion); Add writes the current one.
+	SchemaVersion int    `json:"schema_version,omitempty"`
+	CaseID        string `json:"case_id"`
+	// ItemID names the sub-question for records taken from a compound
+	// verdict; CaseID is then "<case_id>#<item_id>".
+	ItemID         string        `json:"item_id,omitempty"`
 	Type           string        `json:"type"`
 	Summary        string        `json:"summary"`
 	Verdict        core.Decision `json:"verdict"`
+	Outcome        string        `json:"outcome,omitempty"`
 	Reasoning      string        `json:"reasoning"`
 	Implementation string        `json:"implementation"`
 	Dissent        string        `json:"dissent,omitempty"`
@@ -27,6 +35,11 @@ type Record struct {
 	Judge          string        `json:"judge"`
 	BeadID         string        `json:"bead_id,omitempty"`
 	Keywords       []string      `json:"keywords,omitempty"`
+	// Provisional marks an expedited verdict awaiting ratification; search
+	// hides it once a later record for the same case exists.
+	Provisional bool `json:"provisional,omitempty"`
+	// Ratification is confirmed or overturned for ratified verdicts.
+	Ratification string `json:"ratification,omitempty"`
 }
 
 func FromVerdict(v core.Verdict) Record {
@@ -36,6 +49,7 @@ func FromVerdict(v core.Verdict) Record {
 		Type:           v.Type,
 		Summary:        v.Summary,
 		Verdict:        v.Verdict,
+		Outcome:        v.Outcome,
 		Reasoning:      v.Reasoning,
 		Implementation: v.Implementation,
 		Dissent:        v.Dissent,
@@ -43,13 +57,33 @@ func FromVerdict(v core.Verdict) Record {
 		VerdictAt:      v.VerdictAt,
 		Judge:          v.Judge,
 		Keywords:       keywords,
+		Provisional:    v.Provisional,
 	}
 	if v.Handoff != nil {
 		record.BeadID = v.Handoff.BeadID
 	}
+	if v.Ratification != nil {
+		record.Ratification = v.Ratification.Status
+	}
 	return record
 }
 
+// FromVerdictItems returns the records to index for a verdict: one per
+// sub-question for compound verdicts, otherwise the verdict itself.
+func FromVerdictItems(v core.Verdict) []Record {
+	if len(v.Items) == 0 {
+		return []Record{FromVerdict(v)}
+	}
+	out := make([]Record, 0, len(v.Items))
+	for _, it := range v.Items {
+		item, _ := v.Item(it.ItemID)
+		rec := FromVerdict(item)
+		rec.ItemID = it.ItemID
+		out = append(out, rec)
+	}
+	return out
+}
+
 func (r Record) Validate() error {
 	if strings.TrimSpace(r.CaseID) == "" {
 		return fmt.Errorf("record.case_id is required")
@@ -72,6 +106,10 @@ func (r Record) Validate() error {
 	return nil
 }
 
+// maxRecordSize bounds one JSONL record; bufio.Scanner's 64KB default
+// would stop a scan at a record with long reasoning.
+const maxRecordSize = 64 << 20
+
 // Store appends and searches precedent records in JSONL format.
 type Store struct {
 	path string
@@ -85,6 +123,7 @@ func (s *Store) Add(record Record) error {
 	if err := record.Validate(); err != nil {
 		return err
 	}
+	record.SchemaVersion = core.SchemaVersion
 	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
 		return err
 	}
@@ -98,40 +137,55 @@ func (s *Store) Add(record Record) error {
 		return err
 	}
 	defer f.Close()
-	_, err = f.Write(line)
-	return err
+	if _, err := f.Write(line); err != nil {
+		return err
+	}
+	return f.Sync()
 }
 
 func (s *Store) LoadAll() ([]Record, error) {
+	records, _, err := s.Scan()
+	return records, err
+}
+
+// Scan is LoadAll that also counts the malformed or invalid lines it skips.
+func (s *Store) Scan() ([]Record, int, error) {
 	f, err := os.Open(s.path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return []Record{}, nil
+			return []Record{}, 0, nil
 		}
-		return nil, err
+		return nil, 0, err
 	}
 	defer f.Close()
 
 	var out []Record
+	skipped := 0
 	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
 		if line == "" {
 			continue
 		}
 		var rec Record
-		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+		if err := core.Decode(core.DocPrecedent, []byte(line), &rec); err != nil {
+			if errors.Is(err, core.ErrNewerSchema) {
+				return nil, 0, err
+			}
+			skipped++
 			continue
 		}
 		if err := rec.Validate(); err != nil {
+			skipped++
 			continue
 		}
 		out = append(out, rec)
 	}
 	if err := scanner.Err(); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return out, nil
+	return out, skipped, nil
 }
 
 type SearchOptions struct {
@@ -145,6 +199,12 @@ func (s *Store) Search(query string, opts SearchOptions) ([]Record, error) {
 	if err != nil {
 		return nil, err
 	}
+	return Search(records, query, opts), nil
+}
+
+// Search ranks records, in the order they were added, by keyword matches
+// and then recency, so any record source can be searched.
+func Search(records []Record, query string, opts SearchOptions) []Record {
 	queryTokens := extractKeywords(query)
 	type scored struct {
 		record Record
@@ -152,8 +212,15 @@ func (s *Store) Search(query string, opts SearchOptions) ([]Record, error) {
 		time   time.Time
 	}
 
+	latest := map[string]int{}
+	for i, rec := range records {
+		latest[rec.CaseID] = i
+	}
 	results := make([]scored, 0, len(records))
-	for _, rec := range records {
+	for i, rec := range records {
+		if rec.Provisional && latest[rec.CaseID] != i {
+			continue
+		}
 		if opts.Type != "" && rec.Type != opts.Type {
 			continue
 		}
@@ -191,49 +258,80 @@ func (s *Store) Search(query string, opts SearchOptions) ([]Record, error) {
 	for _, r := range results {
 		out = append(out, r.record)
 	}
-	return out, nil
+	return out
 }
 
 func scoreRecord(rec Record, queryTokens []string) int {
 	if len(queryTokens) == 0 {
 		return 1
 	}
-	bag := strings.ToLower(strings.Join([]string{
+	bag := strings.ToLower(searchText(rec))
+	score := 0
+	for _, q := range queryTokens {
+		if strings.Contains(bag, q) {
+			score++
+		}
+	}
+	return score
+}
+
+// searchText is the text Search matches query keywords against.
+func searchText(rec Record) string {
+	return strings.Join([]string{
 		rec.CaseID,
 		rec.Type,
+		rec.Outcome,
 		rec.Summary,
 		rec.Reasoning,
 		rec.Implementation,
 		rec.Dissent,
 		strings.Join(rec.Keywords, " "),
-	}, " "))
-	score := 0
-	for _, q := range queryTokens {
-		if strings.Contains(bag, q) {
-			score++
+	}, " ")
+}
+
+// Terms are the distinct words of the text Search matches a record by. A
+// query keyword has no spaces or punctuation, so it matches a record only
+// by occurring within one of its terms: an index of terms narrows a search
+// without changing its results.
+func (r Record) Terms() []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, w := range words(searchText(r)) {
+		if !seen[w] {
+			seen[w] = true
+			out = append(out, w)
 		}
 	}
-	return score
+	return out
+}
+
+// QueryKeywords are the keywords Search matches records by for query.
+func QueryKeywords(query string) []string {
+	return extractKeywords(query)
+}
+
+var separators = strings.NewReplacer(
+	",", " ",
+	".", " ",
+	":", " ",
+	";", " ",
+	"(", " ",
+	")", " ",
+	"[", " ",
+	"]", " ",
+	"/", " ",
+	"\\", " ",
+	"\n", " ",
+	"\t", " ",
+)
+
+// words lowercases text and splits it on whitespace and punctuation.
+func words(text string) []string {
+	return strings.Fields(separators.Replace(strings.ToLower(text)))
 }
 
 func extractKeywords(text string) []string {
-	text = strings.ToLower(text)
-	replacer := strings.NewReplacer(
-		",", " ",
-		".", " ",
-		":", " ",
-		";", " ",
-		"(", " ",
-		")", " ",
-		"[", " ",
-		"]", " ",
-		"/", " ",
-		"\\", " ",
-		"\n", " ",
-		"\t", " ",
-	)
-	text = replacer.Replace(text)
-	parts := strings.Fields(text)
+	parts := words(text)
 	if len(parts) == 0 {
 		return nil
 	}
@@ -59,3 +59,26 @@ func TestFromVerdictIncludesHandoff(t *testing.T) {
 		t.Fatalf("expected bead id athena-123, got %q", r.BeadID)
 	}
 }
+
+func TestSearchHidesSupersededProvisionalRecords(t *testing.T) {
+	s := New(filepath.Join(t.TempDir(), "index.jsonl"))
+	now := time.Now().UTC()
+	base := Record{CaseID: "senate-9", Type: "general", Summary: "Unblock the release", Verdict: core.DecisionApprove, Binding: true, VerdictAt: now.Format(time.RFC3339), Judge: "claude:opus"}
+	provisional := base
+	provisional.Provisional = true
+	ratified := base
+	ratified.Verdict = core.DecisionReject
+	ratified.Ratification = core.RatificationOverturned
+	for _, rec := range []Record{provisional, ratified} {
+		if err := s.Add(rec); err != nil {
+			t.Fatalf("add record: %v", err)
+		}
+	}
+	results, err := s.Search("release", SearchOptions{})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(results) != 1 || results[0].Provisional || results[0].Ratification != core.RatificationOverturned {
+		t.Fatalf("expected only the ratified record, got %+v", results)
+	}
+}
@@ -0,0 +1,333 @@
+// Package signing signs verdicts with Senate's ed25519 key and verifies
+// them against the public keyring, including keys since rotated out.
+//
+// A state root keeps its keys under keys/: signing.key holds the current
+// private key (mode 0600) and keyring.json every public key Senate has
+// signed with, oldest first. Rotation retires the current key, keeping its
+// public half in the keyring so verdicts it signed still verify.
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// Algorithm is the only signature algorithm Senate issues.
+const Algorithm = "ed25519"
+
+const keysDir = "keys"
+
+// ErrUnsigned is returned by Verify for a verdict without a signature.
+var ErrUnsigned = errors.New("verdict is not signed")
+
+// Key is one public key in the keyring. A key signs verdicts from
+// CreatedAt until RetiredAt; the current key has no RetiredAt.
+type Key struct {
+	ID        string `json:"key_id"`
+	Algorithm string `json:"algorithm"`
+	PublicKey string `json:"public_key"`
+	CreatedAt string `json:"created_at"`
+	RetiredAt string `json:"retired_at,omitempty"`
+}
+
+// Keyring is the public key history; it is what senate keys export hands
+// to consumers.
+type Keyring struct {
+	Keys []Key `json:"keys"`
+}
+
+// Current returns the key verdicts are signed with now.
+func (r Keyring) Current() (Key, bool) {
+	if n := len(r.Keys); n > 0 && r.Keys[n-1].RetiredAt == "" {
+		return r.Keys[n-1], true
+	}
+	return Key{}, false
+}
+
+// Find returns the key with id.
+func (r Keyring) Find(id string) (Key, bool) {
+	for _, k := range r.Keys {
+		if k.ID == id {
+			return k, true
+		}
+	}
+	return Key{}, false
+}
+
+// privateKey is the on-disk form of signing.key.
+type privateKey struct {
+	ID   string `json:"key_id"`
+	Seed string `json:"seed"`
+}
+
+// KeyringPath is the public keyring of a state root.
+func KeyringPath(root string) string {
+	return filepath.Join(root, keysDir, "keyring.json")
+}
+
+// PrivateKeyPath is the current private key of a state root.
+func PrivateKeyPath(root string) string {
+	return filepath.Join(root, keysDir, "signing.key")
+}
+
+// LoadKeyring reads a keyring file, either a state root's or an exported
+// copy. The error wraps fs.ErrNotExist when there is none.
+func LoadKeyring(path string) (Keyring, error) {
+	var r Keyring
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return r, err
+	}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return r, fmt.Errorf("decode keyring %s: %w", path, err)
+	}
+	return r, nil
+}
+
+// Init creates the first signing key of a state root. It refuses, with an
+// error wrapping fs.ErrExist, when the root already has a keyring.
+func Init(root string, now time.Time) (Key, error) {
+	if _, err := os.Stat(KeyringPath(root)); err == nil {
+		return Key{}, fmt.Errorf("keyring %s: %w", KeyringPath(root), fs.ErrExist)
+	}
+	return addKey(root, Keyring{}, now)
+}
+
+// Rotate retires the current key and creates its successor. The retired
+// private key is discarded; its public key stays in the keyring.
+func Rotate(root string, now time.Time) (retired, current Key, err error) {
+	ring, err := LoadKeyring(KeyringPath(root))
+	if err != nil {
+		return Key{}, Key{}, err
+	}
+	if len(ring.Keys) > 0 && ring.Keys[len(ring.Keys)-1].RetiredAt == "" {
+		ring.Keys[len(ring.Keys)-1].RetiredAt = now.UTC().Format(time.RFC3339)
+		retired = ring.Keys[len(ring.Keys)-1]
+	}
+	current, err = addKey(root, ring, now)
+	return retired, current, err
+}
+
+// addKey generates a key, appends it to ring and writes both files. The
+// private key is written first, so a failure never leaves a keyring whose
+// current key cannot sign.
+func addKey(root string, ring Keyring, now time.Time) (Key, error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return Key{}, err
+	}
+	sum := sha256.Sum256(pub)
+	k := Key{
+		ID:        hex.EncodeToString(sum[:8]),
+		Algorithm: Algorithm,
+		PublicKey: base64.StdEncoding.EncodeToString(pub),
+		CreatedAt: now.UTC().Format(time.RFC3339),
+	}
+	secret, err := json.Marshal(privateKey{ID: k.ID, Seed: base64.StdEncoding.EncodeToString(priv.Seed())})
+	if err != nil {
+		return Key{}, err
+	}
+	if err := writeFile(PrivateKeyPath(root), append(secret, '\n'), 0o600); err != nil {
+		return Key{}, err
+	}
+	ring.Keys = append(ring.Keys, k)
+	return k, WriteKeyring(KeyringPath(root), ring)
+}
+
+// Signer signs verdicts with a state root's current key.
+type Signer struct {
+	key  Key
+	priv ed25519.PrivateKey
+}
+
+// Configured reports whether senate keys init was ever run for root: its
+// keys directory exists, even if a key file has since gone missing.
+func Configured(root string) bool {
+	info, err := os.Stat(filepath.Join(root, keysDir))
+	return err == nil && info.IsDir()
+}
+
+// WriteKeyring writes ring to path through a temp file, so a consumer never
+// reads a partial export.
+func WriteKeyring(path string, ring Keyring) error {
+	data, err := json.MarshalIndent(ring, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFile(path, append(data, '\n'), 0o644)
+}
+
+// LoadSigner reads the current key of a state root. The error wraps
+// fs.ErrNotExist when the root has no key.
+func LoadSigner(root string) (*Signer, error) {
+	ring, err := LoadKeyring(KeyringPath(root))
+	if err != nil {
+		return nil, err
+	}
+	key, ok := ring.Current()
+	if !ok {
+		return nil, fmt.Errorf("keyring %s has no current key: %w", KeyringPath(root), fs.ErrNotExist)
+	}
+	data, err := os.ReadFile(PrivateKeyPath(root))
+	if err != nil {
+		return nil, err
+	}
+	var pk privateKey
+	if err := json.Unmarshal(data, &pk); err != nil {
+		return nil, fmt.Errorf("decode %s: %w", PrivateKeyPath(root), err)
+	}
+	if pk.ID != key.ID {
+		return nil, fmt.Errorf("signing key %s is not the current key %s", pk.ID, key.ID)
+	}
+	seed, err := base64.StdEncoding.DecodeString(pk.Seed)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("decode %s: malformed seed", PrivateKeyPath(root))
+	}
+	return &Signer{key: key, priv: ed25519.NewKeyFromSeed(seed)}, nil
+}
+
+// Key returns the public key the signer signs with.
+func (s *Signer) Key() Key { return s.key }
+
+// Sign sets v.Signature, replacing any earlier one.
+func (s *Signer) Sign(v *core.Verdict, now time.Time) error {
+	v.Signature = &core.Signature{KeyID: s.key.ID, Algorithm: Algorithm, SignedAt: now.UTC().Format(time.RFC3339)}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	msg, err := payload(data)
+	if err != nil {
+		return err
+	}
+	v.Signature.Value = base64.StdEncoding.EncodeToString(ed25519.Sign(s.priv, msg))
+	return nil
+}
+
+// Verify checks v's signature against ring and returns the key that made
+// it. The key must have been current when the verdict was signed.
+func Verify(v core.Verdict, ring Keyring) (Key, error) {
+	if v.Signature == nil {
+		return Key{}, ErrUnsigned
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return Key{}, err
+	}
+	return VerifyDocument(data, ring)
+}
+
+// VerifyDocument is Verify for a verdict as read from disk or a bead. It
+// checks the document itself, so fields this build does not know are
+// covered too.
+func VerifyDocument(data []byte, ring Keyring) (Key, error) {
+	var doc struct {
+		Signature *core.Signature `json:"signature"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return Key{}, fmt.Errorf("decode verdict: %w", err)
+	}
+	sig := doc.Signature
+	if sig == nil {
+		return Key{}, ErrUnsigned
+	}
+	if sig.Algorithm != Algorithm {
+		return Key{}, fmt.Errorf("unsupported algorithm %q", sig.Algorithm)
+	}
+	key, ok := ring.Find(sig.KeyID)
+	if !ok {
+		return Key{}, fmt.Errorf("key %s is not in the keyring", sig.KeyID)
+	}
+	signedAt, err := time.Parse(time.RFC3339, sig.SignedAt)
+	if err != nil {
+		return key, fmt.Errorf("signed_at must be RFC3339: %w", err)
+	}
+	if created, err := time.Parse(time.RFC3339, key.CreatedAt); err == nil && signedAt.Before(created) {
+		return key, fmt.Errorf("signed at %s, before key %s was created", sig.SignedAt, key.ID)
+	}
+	if retired, err := time.Parse(time.RFC3339, key.RetiredAt); err == nil && signedAt.After(retired) {
+		return key, fmt.Errorf("signed at %s, after key %s was retired", sig.SignedAt, key.ID)
+	}
+	pub, err := base64.StdEncoding.DecodeString(key.PublicKey)
+	if err != nil || len(pub) != ed25519.PublicKeySize {
+		return key, fmt.Errorf("key %s: malformed public key", key.ID)
+	}
+	value, err := base64.StdEncoding.DecodeString(sig.Value)
+	if err != nil {
+		return key, fmt.Errorf("malformed signature: %w", err)
+	}
+	msg, err := payload(data)
+	if err != nil {
+		return key, err
+	}
+	if !ed25519.Verify(pub, msg, value) {
+		return key, errors.New("signature does not match the verdict")
+	}
+	return key, nil
+}
+
+// payload is the signed message: the verdict document in core's canonical
+// encoding with the signature value left out, so it does not depend on the
+// schema version or on the build that encoded the verdict.
+func payload(data []byte) ([]byte, error) {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	var sig map[string]json.RawMessage
+	if err := json.Unmarshal(doc["signature"], &sig); err != nil {
+		return nil, fmt.Errorf("decode signature: %w", err)
+	}
+	delete(sig, "value")
+	raw, err := json.Marshal(sig)
+	if err != nil {
+		return nil, err
+	}
+	doc["signature"] = raw
+	if data, err = json.Marshal(doc); err != nil {
+		return nil, err
+	}
+	return core.Canonical(data)
+}
+
+// writeFile replaces path with data through a temp file in the same dir.
+func writeFile(path string, data []byte, perm fs.FileMode) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(perm); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
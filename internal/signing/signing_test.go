@@ -0,0 +1,157 @@
+package signing
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+var now = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func testVerdict() core.Verdict {
+	at := now.Format(time.RFC3339)
+	return core.Verdict{CaseID: "senate-s", FiledAt: at, VerdictAt: at, Type: "architecture", Summary: "Adopt the cache", Verdict: core.DecisionApprove, Reasoning: "sound", Judge: "judge", Binding: true}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	root := t.TempDir()
+	if _, err := LoadSigner(root); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected no signer before init, got %v", err)
+	}
+	k, err := Init(root, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Init(root, now); !errors.Is(err, fs.ErrExist) {
+		t.Fatalf("expected a second init to be refused, got %v", err)
+	}
+	if info, err := os.Stat(PrivateKeyPath(root)); err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected a private key readable by its owner only, got %v (%v)", info.Mode(), err)
+	}
+	signer, err := LoadSigner(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := testVerdict()
+	if err := signer.Sign(&v, now); err != nil {
+		t.Fatal(err)
+	}
+	ring, err := LoadKeyring(KeyringPath(root))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := Verify(v, ring); err != nil || got.ID != k.ID {
+		t.Fatalf("expected a valid signature by %s, got %s (%v)", k.ID, got.ID, err)
+	}
+
+	tampered := v
+	tampered.Verdict = core.DecisionReject
+	if _, err := Verify(tampered, ring); err == nil {
+		t.Fatal("expected an altered verdict to fail verification")
+	}
+	if _, err := Verify(testVerdict(), ring); !errors.Is(err, ErrUnsigned) {
+		t.Fatalf("expected ErrUnsigned, got %v", err)
+	}
+	if _, err := Verify(v, Keyring{}); err == nil {
+		t.Fatal("expected an unknown key to fail verification")
+	}
+}
+
+func TestVerifyAcrossRotation(t *testing.T) {
+	root := t.TempDir()
+	if _, err := Init(root, now); err != nil {
+		t.Fatal(err)
+	}
+	old, err := LoadSigner(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := testVerdict()
+	if err := old.Sign(&before, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	retired, current, err := Rotate(root, now.Add(2*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retired.ID != old.Key().ID || retired.RetiredAt == "" || current.ID == retired.ID {
+		t.Fatalf("unexpected rotation: retired %+v current %+v", retired, current)
+	}
+	signer, err := LoadSigner(root)
+	if err != nil || signer.Key().ID != current.ID {
+		t.Fatalf("expected the new key to sign, got %v", err)
+	}
+	after := testVerdict()
+	if err := signer.Sign(&after, now.Add(3*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	ring, err := LoadKeyring(KeyringPath(root))
+	if err != nil || len(ring.Keys) != 2 {
+		t.Fatalf("expected both keys in the keyring, got %+v (%v)", ring, err)
+	}
+	for _, v := range []core.Verdict{before, after} {
+		if _, err := Verify(v, ring); err != nil {
+			t.Fatalf("expected %s signature to verify: %v", v.Signature.KeyID, err)
+		}
+	}
+	// The retired key no longer vouches for anything signed after it retired.
+	late := testVerdict()
+	if err := old.Sign(&late, now.Add(3*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Verify(late, ring); err == nil {
+		t.Fatal("expected a signature by a retired key to fail")
+	}
+}
+
+func TestSignatureSurvivesReencoding(t *testing.T) {
+	root := t.TempDir()
+	if _, err := Init(root, now); err != nil {
+		t.Fatal(err)
+	}
+	signer, err := LoadSigner(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := testVerdict()
+	if err := signer.Sign(&v, now); err != nil {
+		t.Fatal(err)
+	}
+	ring, err := LoadKeyring(KeyringPath(root))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A later build stores the verdict at a newer schema version with a field
+	// this one does not know, left unset.
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	doc["schema_version"] = 2
+	doc["appeal"] = nil
+	later, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range [][]byte{data, later} {
+		if _, err := VerifyDocument(d, ring); err != nil {
+			t.Fatalf("expected the stored verdict to verify: %v\n%s", err, d)
+		}
+	}
+	doc["reasoning"] = "rewritten"
+	edited, _ := json.Marshal(doc)
+	if _, err := VerifyDocument(edited, ring); err == nil {
+		t.Fatal("expected an edited verdict to fail verification")
+	}
+}
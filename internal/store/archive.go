@@ -0,0 +1,275 @@
+package store
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/Perttulands/senate/internal/core"
+)
+
+// archiveDir holds transcript bundles in every backend: they are files
+// under the state root, like prompts.
+const archiveDir = "archive"
+
+var monthPattern = regexp.MustCompile(`^\d{4}-(0[1-9]|1[0-2])$`)
+
+// transcriptArchive is the compressed cold storage for transcripts: gzipped
+// JSONL bundles per month, plus index.json mapping each archived case ID to
+// its bundle. A month's first bundle is named after the month (2026-03);
+// each later archive run adds the next part (2026-03.2, 2026-03.3, ...)
+// instead of rewriting the bundles already written. Bundles are written
+// before the index and the hot copies are removed last, so a transcript is
+// readable throughout.
+type transcriptArchive struct {
+	root string
+}
+
+// ArchivePath is the newest transcript bundle for month (YYYY-MM), or the
+// month's first bundle when none is written yet.
+func (d *Dir) ArchivePath(month string) string {
+	a := transcriptArchive{d.Root}
+	return a.bundlePath(a.partName(month, a.parts(month)))
+}
+
+// bundlePath is the file of a bundle named by month or month.part.
+func (a transcriptArchive) bundlePath(bundle string) string {
+	return filepath.Join(a.root, archiveDir, "transcripts-"+bundle+".jsonl.gz")
+}
+
+// parts counts the bundles written for month.
+func (a transcriptArchive) parts(month string) int {
+	n := 0
+	for {
+		if _, err := os.Stat(a.bundlePath(a.partName(month, n+1))); err != nil {
+			return n
+		}
+		n++
+	}
+}
+
+// partName names month's nth bundle; the first (or none yet) carries no
+// part number.
+func (a transcriptArchive) partName(month string, n int) string {
+	if n <= 1 {
+		return month
+	}
+	return month + "." + strconv.Itoa(n)
+}
+
+// nextBundle names the bundle the next archive run for month writes.
+func (a transcriptArchive) nextBundle(month string) string {
+	return a.partName(month, a.parts(month)+1)
+}
+
+func (a transcriptArchive) indexPath() string {
+	return filepath.Join(a.root, archiveDir, "index.json")
+}
+
+// index maps archived case IDs to their bundle month; a missing index is
+// empty.
+func (a transcriptArchive) index() (map[string]string, error) {
+	idx := map[string]string{}
+	data, err := os.ReadFile(a.indexPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return idx, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, fmt.Errorf("decode archive index: %w", err)
+	}
+	return idx, nil
+}
+
+// ids lists archived case IDs, sorted ascending.
+func (a transcriptArchive) ids() ([]string, error) {
+	idx, err := a.index()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(idx))
+	for id := range idx {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// load reads an archived transcript. ok is false when it is not archived.
+func (a transcriptArchive) load(caseID string) (t core.Transcript, ok bool, err error) {
+	idx, err := a.index()
+	if err != nil {
+		return t, false, err
+	}
+	bundle, ok := idx[caseID]
+	if !ok {
+		return t, false, nil
+	}
+	docs, err := a.readBundle(bundle)
+	if err != nil {
+		return t, true, err
+	}
+	data, ok := docs[caseID]
+	if !ok {
+		return t, true, fmt.Errorf("transcript %s: missing from bundle %s: %w", caseID, bundle, fs.ErrNotExist)
+	}
+	if err := core.Decode(core.DocTranscript, data, &t); err != nil {
+		return t, true, fmt.Errorf("decode transcript %s: %w", caseID, err)
+	}
+	return t, true, nil
+}
+
+// readBundle returns a bundle's documents by case ID; a missing bundle is
+// empty.
+func (a transcriptArchive) readBundle(bundle string) (map[string][]byte, error) {
+	docs := map[string][]byte{}
+	f, err := os.Open(a.bundlePath(bundle))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return docs, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("bundle %s: %w", bundle, err)
+	}
+	defer zr.Close()
+	scanner := newLineScanner(zr)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var head struct {
+			CaseID string `json:"case_id"`
+		}
+		if err := json.Unmarshal(line, &head); err != nil {
+			return nil, fmt.Errorf("bundle %s: %w", bundle, err)
+		}
+		docs[head.CaseID] = append([]byte(nil), line...)
+	}
+	return docs, scanner.Err()
+}
+
+// add writes stored transcript documents, keyed by case ID, to a new bundle
+// for month and indexes them there; the month's earlier bundles are left
+// untouched. A document archived before is indexed to the new bundle.
+func (a transcriptArchive) add(month string, docs map[string][]byte) error {
+	if !monthPattern.MatchString(month) {
+		return fmt.Errorf("archive month %q must be YYYY-MM", month)
+	}
+	name := a.nextBundle(month)
+	bundle := make(map[string][]byte, len(docs))
+	for id, data := range docs {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, data); err != nil {
+			return fmt.Errorf("transcript %s: %w", id, err)
+		}
+		bundle[id] = buf.Bytes()
+	}
+	ids := make([]string, 0, len(bundle))
+	for id := range bundle {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var out bytes.Buffer
+	zw := gzip.NewWriter(&out)
+	for _, id := range ids {
+		if _, err := zw.Write(append(bundle[id], '\n')); err != nil {
+			return err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	if err := atomicWrite(a.bundlePath(name), out.Bytes()); err != nil {
+		return err
+	}
+
+	idx, err := a.index()
+	if err != nil {
+		return err
+	}
+	for id := range docs {
+		idx[id] = name
+	}
+	return atomicWriteJSON(a.indexPath(), idx)
+}
+
+// ArchiveTranscripts moves transcripts into month's bundle and removes
+// their files from transcripts/.
+func (d *Dir) ArchiveTranscripts(month string, caseIDs []string) error {
+	return d.locked(func() error {
+		docs := map[string][]byte{}
+		for _, id := range caseIDs {
+			data, err := os.ReadFile(d.TranscriptPath(id))
+			if err != nil {
+				return err
+			}
+			docs[id] = data
+		}
+		if err := (transcriptArchive{d.Root}).add(month, docs); err != nil {
+			return err
+		}
+		for _, id := range caseIDs {
+			if err := os.Remove(d.TranscriptPath(id)); err != nil {
+				return err
+			}
+		}
+		return syncDir(filepath.Join(d.Root, transcriptsDir))
+	})
+}
+
+// ArchivedTranscriptIDs lists case IDs whose transcript is read from a
+// bundle, sorted ascending.
+func (d *Dir) ArchivedTranscriptIDs() ([]string, error) {
+	hot, err := listIDs(filepath.Join(d.Root, transcriptsDir))
+	if err != nil {
+		return nil, err
+	}
+	archived, err := transcriptArchive{d.Root}.ids()
+	if err != nil {
+		return nil, err
+	}
+	return subtract(archived, hot), nil
+}
+
+// mergeIDs returns the sorted union of two ID lists.
+func mergeIDs(a, b []string) []string {
+	seen := map[string]bool{}
+	out := []string{}
+	for _, id := range append(append([]string{}, a...), b...) {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// subtract returns the IDs of a not in b.
+func subtract(a, b []string) []string {
+	drop := map[string]bool{}
+	for _, id := range b {
+		drop[id] = true
+	}
+	out := []string{}
+	for _, id := range a {
+		if !drop[id] {
+			out = append(out, id)
+		}
+	}
+	return out
+}
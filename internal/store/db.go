@@ -0,0 +1,744 @@
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/ledger"
+	"github.com/Perttulands/senate/internal/precedent"
+)
+
+// DBFile is the database file name within a state root. Open picks the DB
+// backend when it exists.
+const DBFile = "senate.db"
+
+var (
+	casesBucket       = []byte(casesDir)
+	transcriptsBucket = []byte(transcriptsDir)
+	verdictsBucket    = []byte(verdictsDir)
+	pendingBucket     = []byte(pendingDir)
+	votesBucket       = []byte(votesDir)
+	precedentsBucket  = []byte(precedentsDir)
+	outboxBucket      = []byte(outboxDir)
+	ledgerBucket      = []byte(ledgerDir)
+
+	// precedentIndexBucket maps search terms, types and case IDs to the
+	// sequence keys of the precedent records that carry them.
+	precedentIndexBucket = []byte("precedent-index")
+	indexTerms           = []byte("terms")
+	indexTypes           = []byte("types")
+	indexCases           = []byte("cases")
+	// indexLong holds records with a term too long to be a bbolt key; every
+	// keyword search reads them.
+	indexLong = []byte("long")
+)
+
+// maxTermLen bounds an indexed term well under bbolt's key size limit.
+const maxTermLen = 1024
+
+// DB stores Senate state in a single embedded bbolt file. Each write is its
+// own transaction and Update groups several into one. Prompts stay as files
+// under the state root because they are handed to people.
+//
+// Like the directory layout's lock, the file is held only for the length of
+// each transaction: reads open it read-only, so readers share it and leave it
+// untouched, and writes open it read-write. Work a command does between
+// transactions, such as a handoff, never blocks other processes.
+type DB struct {
+	Root string
+	// mu keeps this process's own handles from waiting on each other: bbolt
+	// locks the file per handle, and a writer excludes every reader.
+	mu sync.RWMutex
+}
+
+var _ Store = (*DB)(nil)
+
+// OpenDB opens the database in root, creating it if it does not exist.
+// Each transaction waits up to five seconds for another process writing it.
+func OpenDB(root string) (*DB, error) {
+	if strings.TrimSpace(root) == "" {
+		root = "state"
+	}
+	s := &DB{Root: root}
+	if _, err := os.Stat(s.path()); err == nil {
+		return s, s.view(func(dbTx) error { return nil })
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("create dir %s: %w", root, err)
+	}
+	return s, s.update(func(dbTx) error { return nil })
+}
+
+func (s *DB) path() string { return filepath.Join(s.Root, DBFile) }
+
+func (s *DB) open(readOnly bool) (*bolt.DB, error) {
+	db, err := bolt.Open(s.path(), 0o644, &bolt.Options{Timeout: 5 * time.Second, ReadOnly: readOnly})
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", s.path(), err)
+	}
+	return db, nil
+}
+
+// Close is a no-op: no handle outlives its transaction.
+func (s *DB) Close() error { return nil }
+
+func (s *DB) StateRoot() string { return s.Root }
+
+// Update runs fn in a single read-write transaction.
+func (s *DB) Update(fn func(Store) error) error {
+	return s.update(func(t dbTx) error { return fn(t) })
+}
+
+// View runs fn in a single read-only transaction.
+func (s *DB) View(fn func(Store) error) error {
+	return s.view(func(t dbTx) error { return fn(t) })
+}
+
+func (s *DB) view(fn func(dbTx) error) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	db, err := s.open(true)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return db.View(func(tx *bolt.Tx) error { return fn(dbTx{db: s, tx: tx}) })
+}
+
+func (s *DB) update(fn func(dbTx) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	db, err := s.open(false)
+	if err != nil {
+		return err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		if err := createBuckets(tx); err != nil {
+			return err
+		}
+		return fn(dbTx{db: s, tx: tx})
+	})
+	if cerr := db.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// createBuckets creates any bucket the database lacks, indexing the
+// precedents already stored when the index is new.
+func createBuckets(tx *bolt.Tx) error {
+	for _, name := range [][]byte{casesBucket, transcriptsBucket, verdictsBucket, pendingBucket, votesBucket, precedentsBucket, outboxBucket, ledgerBucket} {
+		if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+			return err
+		}
+	}
+	if tx.Bucket(precedentIndexBucket) != nil {
+		return nil
+	}
+	if _, err := tx.CreateBucket(precedentIndexBucket); err != nil {
+		return err
+	}
+	return tx.Bucket(precedentsBucket).ForEach(func(seq, data []byte) error {
+		var r precedent.Record
+		if err := core.Decode(core.DocPrecedent, data, &r); err != nil {
+			return err
+		}
+		return indexPrecedent(tx, seq, r)
+	})
+}
+
+func (s *DB) SaveCase(c core.Case) error {
+	return s.update(func(t dbTx) error { return t.SaveCase(c) })
+}
+
+func (s *DB) ReplaceCase(c core.Case) error {
+	return s.update(func(t dbTx) error { return t.ReplaceCase(c) })
+}
+
+func (s *DB) LoadCase(caseID string) (c core.Case, err error) {
+	err = s.view(func(t dbTx) error { c, err = t.LoadCase(caseID); return err })
+	return c, err
+}
+
+func (s *DB) CaseIDs() (ids []string, err error) {
+	err = s.view(func(t dbTx) error { ids, err = t.CaseIDs(); return err })
+	return ids, err
+}
+
+func (s *DB) SaveTranscript(tr core.Transcript) error {
+	return s.update(func(t dbTx) error { return t.SaveTranscript(tr) })
+}
+
+func (s *DB) LoadTranscript(caseID string) (tr core.Transcript, err error) {
+	err = s.view(func(t dbTx) error { tr, err = t.LoadTranscript(caseID); return err })
+	return tr, err
+}
+
+func (s *DB) TranscriptIDs() (ids []string, err error) {
+	err = s.view(func(t dbTx) error { ids, err = t.TranscriptIDs(); return err })
+	return ids, err
+}
+
+func (s *DB) ArchiveTranscripts(month string, caseIDs []string) error {
+	return s.update(func(t dbTx) error { return t.ArchiveTranscripts(month, caseIDs) })
+}
+
+func (s *DB) ArchivedTranscriptIDs() (ids []string, err error) {
+	err = s.view(func(t dbTx) error { ids, err = t.ArchivedTranscriptIDs(); return err })
+	return ids, err
+}
+
+func (s *DB) SaveVerdict(v core.Verdict) error {
+	return s.update(func(t dbTx) error { return t.SaveVerdict(v) })
+}
+
+func (s *DB) ReplaceVerdict(v core.Verdict) error {
+	return s.update(func(t dbTx) error { return t.ReplaceVerdict(v) })
+}
+
+func (s *DB) LoadVerdict(caseID string) (v core.Verdict, err error) {
+	err = s.view(func(t dbTx) error { v, err = t.LoadVerdict(caseID); return err })
+	return v, err
+}
+
+func (s *DB) VerdictIDs() (ids []string, err error) {
+	err = s.view(func(t dbTx) error { ids, err = t.VerdictIDs(); return err })
+	return ids, err
+}
+
+func (s *DB) SavePending(p core.PendingDeliberation) error {
+	return s.update(func(t dbTx) error { return t.SavePending(p) })
+}
+
+func (s *DB) LoadPending(caseID string) (p core.PendingDeliberation, err error) {
+	err = s.view(func(t dbTx) error { p, err = t.LoadPending(caseID); return err })
+	return p, err
+}
+
+func (s *DB) DeletePending(caseID string) error {
+	return s.update(func(t dbTx) error { return t.DeletePending(caseID) })
+}
+
+func (s *DB) PendingIDs() (ids []string, err error) {
+	err = s.view(func(t dbTx) error { ids, err = t.PendingIDs(); return err })
+	return ids, err
+}
+
+func (s *DB) AppendVote(v core.Vote) error {
+	return s.update(func(t dbTx) error { return t.AppendVote(v) })
+}
+
+func (s *DB) LoadVotes(caseID string) (votes []core.Vote, err error) {
+	err = s.view(func(t dbTx) error { votes, err = t.LoadVotes(caseID); return err })
+	return votes, err
+}
+
+func (s *DB) WritePrompt(caseID, seat, round, body string) (string, error) {
+	return (&Dir{Root: s.Root}).WritePrompt(caseID, seat, round, body)
+}
+
+func (s *DB) AddPrecedent(r precedent.Record) error {
+	return s.update(func(t dbTx) error { return t.AddPrecedent(r) })
+}
+
+func (s *DB) LoadPrecedents() (records []precedent.Record, err error) {
+	err = s.view(func(t dbTx) error { records, err = t.LoadPrecedents(); return err })
+	return records, err
+}
+
+func (s *DB) SearchPrecedents(query string, opts precedent.SearchOptions) (records []precedent.Record, err error) {
+	err = s.view(func(t dbTx) error { records, err = t.SearchPrecedents(query, opts); return err })
+	return records, err
+}
+
+func (s *DB) AppendOutbox(topic string, envelope any) error {
+	return s.update(func(t dbTx) error { return t.AppendOutbox(topic, envelope) })
+}
+
+func (s *DB) Outbox(topic string) (out []json.RawMessage, err error) {
+	err = s.view(func(t dbTx) error { out, err = t.Outbox(topic); return err })
+	return out, err
+}
+
+func (s *DB) OutboxTopics() (topics []string, err error) {
+	err = s.view(func(t dbTx) error { topics, err = t.OutboxTopics(); return err })
+	return topics, err
+}
+
+func (s *DB) AppendLedger(e ledger.Entry) error {
+	return s.update(func(t dbTx) error { return t.AppendLedger(e) })
+}
+
+func (s *DB) LoadLedger() (entries []ledger.Entry, err error) {
+	err = s.view(func(t dbTx) error { entries, err = t.LoadLedger(); return err })
+	return entries, err
+}
+
+func (s *DB) Location(kind Kind, id string) string {
+	return dbTx{db: s}.Location(kind, id)
+}
+
+// dbTx is the Store view of one bbolt transaction.
+type dbTx struct {
+	db *DB
+	tx *bolt.Tx
+}
+
+func (t dbTx) SaveCase(c core.Case) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	c.SchemaVersion = core.SchemaVersion
+	return t.create(casesBucket, KindCase, c.ID, c)
+}
+
+func (t dbTx) ReplaceCase(c core.Case) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	c.SchemaVersion = core.SchemaVersion
+	return t.put(casesBucket, c.ID, c)
+}
+
+func (t dbTx) LoadCase(caseID string) (core.Case, error) {
+	var c core.Case
+	return c, t.get(casesBucket, KindCase, caseID, &c)
+}
+
+func (t dbTx) CaseIDs() ([]string, error) { return t.keys(casesBucket), nil }
+
+func (t dbTx) SaveTranscript(tr core.Transcript) error {
+	if strings.TrimSpace(tr.CaseID) == "" {
+		return fmt.Errorf("transcript.case_id is required")
+	}
+	tr.SchemaVersion = core.SchemaVersion
+	return t.put(transcriptsBucket, tr.CaseID, tr)
+}
+
+func (t dbTx) LoadTranscript(caseID string) (core.Transcript, error) {
+	var tr core.Transcript
+	if t.tx.Bucket(transcriptsBucket).Get([]byte(caseID)) == nil {
+		if archived, ok, err := t.archive().load(caseID); ok || err != nil {
+			return archived, err
+		}
+	}
+	return tr, t.get(transcriptsBucket, KindTranscript, caseID, &tr)
+}
+
+func (t dbTx) TranscriptIDs() ([]string, error) {
+	archived, err := t.archive().ids()
+	if err != nil {
+		return nil, err
+	}
+	return mergeIDs(t.keys(transcriptsBucket), archived), nil
+}
+
+// ArchiveTranscripts writes the bundle, which is a file under the state
+// root, before deleting the transcripts; if the transaction then fails the
+// hot copies remain and win.
+func (t dbTx) ArchiveTranscripts(month string, caseIDs []string) error {
+	b := t.tx.Bucket(transcriptsBucket)
+	docs := map[string][]byte{}
+	for _, id := range caseIDs {
+		data := b.Get([]byte(id))
+		if data == nil {
+			return fmt.Errorf("%s %s: %w", KindTranscript, id, fs.ErrNotExist)
+		}
+		docs[id] = append([]byte(nil), data...)
+	}
+	if err := t.archive().add(month, docs); err != nil {
+		return err
+	}
+	for _, id := range caseIDs {
+		if err := b.Delete([]byte(id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t dbTx) ArchivedTranscriptIDs() ([]string, error) {
+	archived, err := t.archive().ids()
+	if err != nil {
+		return nil, err
+	}
+	return subtract(archived, t.keys(transcriptsBucket)), nil
+}
+
+func (t dbTx) archive() transcriptArchive { return transcriptArchive{t.db.Root} }
+
+func (t dbTx) SaveVerdict(v core.Verdict) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	v.SchemaVersion = core.SchemaVersion
+	return t.create(verdictsBucket, KindVerdict, v.CaseID, v)
+}
+
+func (t dbTx) ReplaceVerdict(v core.Verdict) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	v.SchemaVersion = core.SchemaVersion
+	return t.put(verdictsBucket, v.CaseID, v)
+}
+
+func (t dbTx) LoadVerdict(caseID string) (core.Verdict, error) {
+	var v core.Verdict
+	return v, t.get(verdictsBucket, KindVerdict, caseID, &v)
+}
+
+func (t dbTx) VerdictIDs() ([]string, error) { return t.keys(verdictsBucket), nil }
+
+func (t dbTx) SavePending(p core.PendingDeliberation) error {
+	if strings.TrimSpace(p.CaseID) == "" {
+		return fmt.Errorf("pending.case_id is required")
+	}
+	p.SchemaVersion = core.SchemaVersion
+	return t.put(pendingBucket, p.CaseID, p)
+}
+
+func (t dbTx) LoadPending(caseID string) (core.PendingDeliberation, error) {
+	var p core.PendingDeliberation
+	return p, t.get(pendingBucket, KindPending, caseID, &p)
+}
+
+func (t dbTx) DeletePending(caseID string) error {
+	return t.tx.Bucket(pendingBucket).Delete([]byte(caseID))
+}
+
+func (t dbTx) PendingIDs() ([]string, error) { return t.keys(pendingBucket), nil }
+
+func (t dbTx) AppendVote(v core.Vote) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	v.SchemaVersion = core.SchemaVersion
+	return t.appendTo(votesBucket, v.CaseID, v)
+}
+
+func (t dbTx) LoadVotes(caseID string) ([]core.Vote, error) {
+	out := []core.Vote{}
+	err := t.each(votesBucket, caseID, func(data []byte) error {
+		var v core.Vote
+		if err := core.Decode(core.DocVote, data, &v); err != nil {
+			return fmt.Errorf("decode vote for %s: %w", caseID, err)
+		}
+		out = append(out, v)
+		return nil
+	})
+	return out, err
+}
+
+func (t dbTx) WritePrompt(caseID, seat, round, body string) (string, error) {
+	return t.db.WritePrompt(caseID, seat, round, body)
+}
+
+func (t dbTx) AddPrecedent(r precedent.Record) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	r.SchemaVersion = core.SchemaVersion
+	b := t.tx.Bucket(precedentsBucket)
+	seq, err := b.NextSequence()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	if err := b.Put(seqKey(seq), data); err != nil {
+		return err
+	}
+	return indexPrecedent(t.tx, seqKey(seq), r)
+}
+
+func (t dbTx) LoadPrecedents() ([]precedent.Record, error) {
+	out := []precedent.Record{}
+	err := t.tx.Bucket(precedentsBucket).ForEach(func(_, data []byte) error {
+		var r precedent.Record
+		if err := core.Decode(core.DocPrecedent, data, &r); err != nil {
+			return err
+		}
+		out = append(out, r)
+		return nil
+	})
+	return out, err
+}
+
+// SearchPrecedents loads only the records the index says could match: those
+// with a term containing a query keyword, of the requested type, and the
+// latest record of each provisional candidate's case, which decides whether
+// it is still shown. precedent.Search then ranks them as it would all.
+func (t dbTx) SearchPrecedents(query string, opts precedent.SearchOptions) ([]precedent.Record, error) {
+	idx := t.tx.Bucket(precedentIndexBucket)
+	keywords := precedent.QueryKeywords(query)
+	if idx == nil || (len(keywords) == 0 && opts.Type == "") {
+		records, err := t.LoadPrecedents()
+		if err != nil {
+			return nil, err
+		}
+		return precedent.Search(records, query, opts), nil
+	}
+	var candidates map[string]bool
+	if len(keywords) > 0 {
+		candidates = map[string]bool{}
+		terms := idx.Bucket(indexTerms)
+		if terms != nil {
+			err := terms.ForEach(func(term, _ []byte) error {
+				for _, k := range keywords {
+					if strings.Contains(string(term), k) {
+						addSeqs(candidates, terms.Bucket(term))
+						return nil
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		addSeqs(candidates, idx.Bucket(indexLong))
+	}
+	if opts.Type != "" {
+		typed := map[string]bool{}
+		if types := idx.Bucket(indexTypes); types != nil {
+			addSeqs(typed, types.Bucket([]byte(opts.Type)))
+		}
+		if candidates == nil {
+			candidates = typed
+		} else {
+			for seq := range candidates {
+				if !typed[seq] {
+					delete(candidates, seq)
+				}
+			}
+		}
+	}
+
+	b := t.tx.Bucket(precedentsBucket)
+	load := func(seqs map[string]bool) (map[string]precedent.Record, error) {
+		out := map[string]precedent.Record{}
+		for seq := range seqs {
+			var r precedent.Record
+			if err := core.Decode(core.DocPrecedent, b.Get([]byte(seq)), &r); err != nil {
+				return nil, err
+			}
+			out[seq] = r
+		}
+		return out, nil
+	}
+	loaded, err := load(candidates)
+	if err != nil {
+		return nil, err
+	}
+	latest := map[string]bool{}
+	for _, r := range loaded {
+		if !r.Provisional {
+			continue
+		}
+		if c := idx.Bucket(indexCases).Bucket([]byte(r.CaseID)); c != nil {
+			if last, _ := c.Cursor().Last(); last != nil && !candidates[string(last)] {
+				latest[string(last)] = true
+			}
+		}
+	}
+	more, err := load(latest)
+	if err != nil {
+		return nil, err
+	}
+	for seq, r := range more {
+		loaded[seq] = r
+	}
+	seqs := make([]string, 0, len(loaded))
+	for seq := range loaded {
+		seqs = append(seqs, seq)
+	}
+	sort.Strings(seqs)
+	records := make([]precedent.Record, 0, len(seqs))
+	for _, seq := range seqs {
+		records = append(records, loaded[seq])
+	}
+	return precedent.Search(records, query, opts), nil
+}
+
+func (t dbTx) AppendOutbox(topic string, envelope any) error {
+	return t.appendTo(outboxBucket, topic, envelope)
+}
+
+func (t dbTx) Outbox(topic string) ([]json.RawMessage, error) {
+	out := []json.RawMessage{}
+	err := t.each(outboxBucket, topic, func(data []byte) error {
+		out = append(out, append(json.RawMessage(nil), data...))
+		return nil
+	})
+	return out, err
+}
+
+func (t dbTx) OutboxTopics() ([]string, error) { return t.keys(outboxBucket), nil }
+
+func (t dbTx) AppendLedger(e ledger.Entry) error {
+	b := t.tx.Bucket(ledgerBucket)
+	seq, err := b.NextSequence()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return b.Put(seqKey(seq), data)
+}
+
+func (t dbTx) LoadLedger() ([]ledger.Entry, error) {
+	out := []ledger.Entry{}
+	err := t.tx.Bucket(ledgerBucket).ForEach(func(_, data []byte) error {
+		var e ledger.Entry
+		if err := json.Unmarshal(data, &e); err != nil {
+			return fmt.Errorf("decode ledger entry: %w", err)
+		}
+		out = append(out, e)
+		return nil
+	})
+	return out, err
+}
+
+// Update runs fn within the enclosing transaction.
+func (t dbTx) Update(fn func(Store) error) error { return fn(t) }
+
+// View runs fn within the enclosing transaction.
+func (t dbTx) View(fn func(Store) error) error { return fn(t) }
+
+func (t dbTx) Close() error { return nil }
+
+func (t dbTx) StateRoot() string { return t.db.Root }
+
+func (t dbTx) Location(kind Kind, id string) string {
+	if kind == KindArchive {
+		return (&Dir{Root: t.db.Root}).ArchivePath(id)
+	}
+	bucket := map[Kind][]byte{KindCase: casesBucket, KindTranscript: transcriptsBucket, KindVerdict: verdictsBucket, KindPending: pendingBucket, KindOutbox: outboxBucket, KindLedger: ledgerBucket}[kind]
+	return filepath.Join(t.db.Root, DBFile) + "#" + string(bucket) + "/" + id
+}
+
+func (t dbTx) put(bucket []byte, id string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return t.tx.Bucket(bucket).Put([]byte(id), data)
+}
+
+func (t dbTx) create(bucket []byte, kind Kind, id string, v any) error {
+	if t.tx.Bucket(bucket).Get([]byte(id)) != nil {
+		return fmt.Errorf("%s %s: %w", kind, id, fs.ErrExist)
+	}
+	return t.put(bucket, id, v)
+}
+
+func (t dbTx) get(bucket []byte, kind Kind, id string, v any) error {
+	data := t.tx.Bucket(bucket).Get([]byte(id))
+	if data == nil {
+		return fmt.Errorf("%s %s: %w", kind, id, fs.ErrNotExist)
+	}
+	if err := core.Decode(string(kind), data, v); err != nil {
+		return fmt.Errorf("decode %s %s: %w", kind, id, err)
+	}
+	return nil
+}
+
+// keys lists a bucket's keys; bbolt keeps them sorted.
+func (t dbTx) keys(bucket []byte) []string {
+	ids := []string{}
+	_ = t.tx.Bucket(bucket).ForEach(func(k, _ []byte) error {
+		ids = append(ids, string(k))
+		return nil
+	})
+	return ids
+}
+
+// appendTo adds v to the sequence kept in the named sub-bucket of parent.
+func (t dbTx) appendTo(parent []byte, name string, v any) error {
+	b, err := t.tx.Bucket(parent).CreateBucketIfNotExists([]byte(name))
+	if err != nil {
+		return err
+	}
+	seq, err := b.NextSequence()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return b.Put(seqKey(seq), data)
+}
+
+func (t dbTx) each(parent []byte, name string, fn func([]byte) error) error {
+	b := t.tx.Bucket(parent).Bucket([]byte(name))
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(func(_, data []byte) error { return fn(data) })
+}
+
+func seqKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+// indexPrecedent files the record stored under seq by its search terms, its
+// type and its case.
+func indexPrecedent(tx *bolt.Tx, seq []byte, r precedent.Record) error {
+	idx := tx.Bucket(precedentIndexBucket)
+	put := func(group []byte, key string) error {
+		g, err := idx.CreateBucketIfNotExists(group)
+		if err != nil {
+			return err
+		}
+		if key == "" {
+			return g.Put(seq, nil)
+		}
+		b, err := g.CreateBucketIfNotExists([]byte(key))
+		if err != nil {
+			return err
+		}
+		return b.Put(seq, nil)
+	}
+	for _, term := range r.Terms() {
+		group := indexTerms
+		if len(term) > maxTermLen {
+			group, term = indexLong, ""
+		}
+		if err := put(group, term); err != nil {
+			return err
+		}
+	}
+	if err := put(indexTypes, r.Type); err != nil {
+		return err
+	}
+	return put(indexCases, r.CaseID)
+}
+
+func addSeqs(set map[string]bool, b *bolt.Bucket) {
+	if b == nil {
+		return
+	}
+	_ = b.ForEach(func(seq, _ []byte) error {
+		set[string(seq)] = true
+		return nil
+	})
+}
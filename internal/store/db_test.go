@@ -0,0 +1,278 @@
+package store
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/precedent"
+)
+
+func openTestDB(t *testing.T, root string) *DB {
+	t.Helper()
+	db, err := OpenDB(root)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestDBImplementsStore(t *testing.T) {
+	testStore(t, openTestDB(t, t.TempDir()))
+}
+
+func TestDBUpdateCommitsTogether(t *testing.T) {
+	db := openTestDB(t, t.TempDir())
+	now := time.Now().UTC().Format(time.RFC3339)
+	c := core.Case{ID: "senate-tx", Type: "general", Summary: "s", Question: "q", FiledAt: now}
+	boom := errors.New("boom")
+	err := db.Update(func(tx Store) error {
+		if err := tx.SaveCase(c); err != nil {
+			return err
+		}
+		return boom
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected aborted update, got %v", err)
+	}
+	if _, err := db.LoadCase(c.ID); err == nil {
+		t.Fatal("expected aborted write to be rolled back")
+	}
+}
+
+func TestDBReadersShareTheFileWithoutChangingIt(t *testing.T) {
+	root := t.TempDir()
+	now := time.Now().UTC().Format(time.RFC3339)
+	db, err := OpenDB(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveCase(core.Case{ID: "senate-ro", Type: "general", Summary: "s", Question: "q", FiledAt: now}); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+	before, err := os.ReadFile(filepath.Join(root, DBFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, second := openTestDB(t, root), openTestDB(t, root)
+	for _, r := range []*DB{first, second} {
+		if _, err := r.LoadCase("senate-ro"); err != nil {
+			t.Fatalf("read: %v", err)
+		}
+		if _, err := r.SearchPrecedents("anything", precedent.SearchOptions{}); err != nil {
+			t.Fatalf("search: %v", err)
+		}
+	}
+	after, err := os.ReadFile(filepath.Join(root, DBFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(before, after) {
+		t.Fatal("reading changed the database file")
+	}
+
+	if err := first.ReplaceCase(core.Case{ID: "senate-ro", Type: "general", Summary: "changed", Question: "q", FiledAt: now}); err != nil {
+		t.Fatalf("first write: %v", err)
+	}
+	if c, err := first.LoadCase("senate-ro"); err != nil || c.Summary != "changed" {
+		t.Fatalf("expected the write to be readable, got %+v (%v)", c, err)
+	}
+}
+
+func TestDBHandlesHoldTheFileOnlyDuringTransactions(t *testing.T) {
+	root := t.TempDir()
+	now := time.Now().UTC().Format(time.RFC3339)
+	first, second := openTestDB(t, root), openTestDB(t, root)
+	if err := first.SaveCase(core.Case{ID: "senate-a", Type: "general", Summary: "s", Question: "q", FiledAt: now}); err != nil {
+		t.Fatal(err)
+	}
+
+	// first stays open, as a command does across its handoff; second must
+	// neither wait on it nor time out.
+	start := time.Now()
+	if err := second.SaveCase(core.Case{ID: "senate-b", Type: "general", Summary: "s", Question: "q", FiledAt: now}); err != nil {
+		t.Fatal(err)
+	}
+	if waited := time.Since(start); waited > time.Second {
+		t.Fatalf("second handle waited %s on an idle one", waited)
+	}
+	if _, err := first.LoadCase("senate-b"); err != nil {
+		t.Fatalf("first handle misses the second's write: %v", err)
+	}
+
+	// A slow transaction on one handle delays the other only until it commits.
+	inside := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- first.Update(func(tx Store) error {
+			close(inside)
+			time.Sleep(200 * time.Millisecond)
+			return tx.SaveCase(core.Case{ID: "senate-c", Type: "general", Summary: "s", Question: "q", FiledAt: now})
+		})
+	}()
+	<-inside
+	if err := second.SaveCase(core.Case{ID: "senate-d", Type: "general", Summary: "s", Question: "q", FiledAt: now}); err != nil {
+		t.Fatalf("write waiting on a slow transaction: %v", err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if ids, err := second.CaseIDs(); err != nil || len(ids) != 4 {
+		t.Fatalf("expected four cases, got %v (%v)", ids, err)
+	}
+}
+
+func TestDBIndexedSearchMatchesScan(t *testing.T) {
+	root := t.TempDir()
+	db := openTestDB(t, root)
+	now := time.Now().UTC()
+	records := []precedent.Record{
+		{CaseID: "senate-1", Type: "code_review", Summary: "Refactor the scheduler", Reasoning: "Tests cover the queue.", Keywords: []string{"scheduler"}},
+		{CaseID: "senate-2", Type: "architecture", Summary: "Split storage/indexing layers", Provisional: true},
+		{CaseID: "senate-2", Type: "architecture", Summary: "Split storage layers", Outcome: "amended"},
+		{CaseID: "senate-3", Type: "code_review", Summary: "Pin scheduler dependencies", Provisional: true},
+		{CaseID: "senate-4", Type: "general", Summary: "Rename the (Queue) service", Dissent: "naming churn"},
+	}
+	for i, r := range records {
+		r.Verdict = core.DecisionApprove
+		r.VerdictAt = now.Add(time.Duration(i) * time.Minute).Format(time.RFC3339)
+		if err := db.AddPrecedent(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	check := func(label string) {
+		t.Helper()
+		all, err := db.LoadPrecedents()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, q := range []string{"", "scheduler", "queue", "sched", "storage indexing", "senate-2", "naming", "missing"} {
+			for _, typ := range []string{"", "code_review", "architecture", "none"} {
+				opts := precedent.SearchOptions{Type: typ}
+				got, err := db.SearchPrecedents(q, opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := precedent.Search(all, q, opts); !reflect.DeepEqual(got, want) {
+					t.Fatalf("%s: search %q type %q: got %+v, want %+v", label, q, typ, got, want)
+				}
+			}
+		}
+	}
+	check("indexed")
+
+	// A database written before the index existed is indexed on its first write.
+	db.Close()
+	raw, err := bolt.Open(filepath.Join(root, DBFile), 0o644, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := raw.Update(func(tx *bolt.Tx) error { return tx.DeleteBucket(precedentIndexBucket) }); err != nil {
+		t.Fatal(err)
+	}
+	raw.Close()
+	db = openTestDB(t, root)
+	check("unindexed")
+	if err := db.SaveCase(core.Case{ID: "senate-5", Type: "general", Summary: "s", Question: "q", FiledAt: now.Format(time.RFC3339)}); err != nil {
+		t.Fatal(err)
+	}
+	check("backfilled")
+}
+
+func TestMigrateBetweenDirAndDB(t *testing.T) {
+	root := t.TempDir()
+	d, err := New(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	if err := d.SaveCase(core.Case{ID: "senate-m", Type: "general", Summary: "s", Question: "q", FiledAt: now}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.AppendOutbox(OutboxCaseFiled, map[string]string{"case_id": "senate-m"}); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := MigrateToDB(root)
+	if err != nil || n.Cases != 1 || n.Outbox != 1 {
+		t.Fatalf("migrate to db: %+v (%v)", n, err)
+	}
+	if _, err := os.Stat(filepath.Join(root, casesDir)); !os.IsNotExist(err) {
+		t.Fatalf("expected case files removed after migration, got %v", err)
+	}
+	s, err := Open(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.(*DB); !ok {
+		t.Fatalf("expected Open to pick the database, got %T", s)
+	}
+	if _, err := s.LoadCase("senate-m"); err != nil {
+		t.Fatalf("load migrated case: %v", err)
+	}
+	s.Close()
+
+	if n, err = MigrateToDir(root); err != nil || n.Cases != 1 {
+		t.Fatalf("migrate to dir: %+v (%v)", n, err)
+	}
+	if _, err := d.LoadCase("senate-m"); err != nil {
+		t.Fatalf("expected case back in the directory layout: %v", err)
+	}
+	if queued, _ := d.Outbox(OutboxCaseFiled); len(queued) != 1 {
+		t.Fatalf("expected outbox restored, got %d", len(queued))
+	}
+}
+
+func TestMigrateCountsSkippedPrecedentsAndRollsBackAFailedCopy(t *testing.T) {
+	root := t.TempDir()
+	d, err := New(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	if err := d.SaveCase(core.Case{ID: "senate-a", Type: "general", Summary: "s", Question: "q", FiledAt: now}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.AddPrecedent(precedent.Record{CaseID: "senate-a", Type: "general", Summary: "s", Verdict: core.DecisionApprove, VerdictAt: now}); err != nil {
+		t.Fatal(err)
+	}
+	if err := AppendJSONL(d.PrecedentIndexPath(), map[string]string{"case_id": "senate-broken"}); err != nil {
+		t.Fatal(err)
+	}
+	n, err := MigrateToDB(root)
+	if err != nil || n.Precedents != 1 || n.PrecedentsSkipped != 1 {
+		t.Fatalf("expected one precedent migrated and one skipped, got %+v (%v)", n, err)
+	}
+
+	// A case the directory layout refuses stops the copy after senate-a.
+	raw, err := bolt.Open(filepath.Join(root, DBFile), 0o644, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = raw.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(casesBucket).Put([]byte("senate-b"), []byte(`{"id":"senate-b"}`))
+	})
+	raw.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MigrateToDir(root); err == nil {
+		t.Fatal("expected the copy to fail on an invalid case")
+	}
+	if _, err := os.Stat(filepath.Join(root, casesDir)); !os.IsNotExist(err) {
+		t.Fatalf("expected the partial copy rolled back, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, DBFile)); err != nil {
+		t.Fatalf("expected the database kept: %v", err)
+	}
+}
@@ -0,0 +1,40 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// LockFile is the advisory lock file within a state root.
+const LockFile = ".lock"
+
+// locked runs fn holding the state root lock exclusively, unless this Dir
+// is the view Update passes to its callback.
+func (d *Dir) locked(fn func() error) error {
+	return d.withLock(true, fn)
+}
+
+// shared runs fn holding the state root lock shared, so readers never see
+// a half-appended line.
+func (d *Dir) shared(fn func() error) error {
+	return d.withLock(false, fn)
+}
+
+func (d *Dir) withLock(exclusive bool, fn func() error) error {
+	if d.held {
+		return fn()
+	}
+	if err := os.MkdirAll(d.Root, 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(filepath.Join(d.Root, LockFile), os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := lockFile(f, exclusive); err != nil {
+		return err
+	}
+	defer unlockFile(f)
+	return fn()
+}
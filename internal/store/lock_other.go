@@ -0,0 +1,11 @@
+//go:build !unix
+
+package store
+
+import "os"
+
+// Advisory locking is only implemented on unix; elsewhere writes rely on
+// atomic renames alone.
+func lockFile(*os.File, bool) error { return nil }
+
+func unlockFile(*os.File) error { return nil }
@@ -0,0 +1,352 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/ledger"
+	"github.com/Perttulands/senate/internal/precedent"
+)
+
+// Memory is an in-process Store for tests and embedding. Records are kept
+// as JSON, so callers never share state with what is stored, and it applies
+// the same validation as Dir. It is safe for concurrent use.
+type Memory struct {
+	mu      sync.Mutex
+	docs    map[Kind]map[string][]byte
+	lines   map[string][][]byte
+	prompts map[string]string
+	// archived holds transcripts moved out by ArchiveTranscripts, by case ID.
+	archived map[string][]byte
+}
+
+var _ Store = (*Memory)(nil)
+
+// NewMemory returns an empty in-memory store.
+func NewMemory() *Memory {
+	return &Memory{
+		docs:     map[Kind]map[string][]byte{},
+		lines:    map[string][][]byte{},
+		prompts:  map[string]string{},
+		archived: map[string][]byte{},
+	}
+}
+
+func (m *Memory) SaveCase(c core.Case) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	c.SchemaVersion = core.SchemaVersion
+	return m.create(KindCase, c.ID, c)
+}
+
+func (m *Memory) ReplaceCase(c core.Case) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	c.SchemaVersion = core.SchemaVersion
+	return m.put(KindCase, c.ID, c)
+}
+
+func (m *Memory) LoadCase(caseID string) (core.Case, error) {
+	var c core.Case
+	return c, m.get(KindCase, caseID, &c)
+}
+
+func (m *Memory) CaseIDs() ([]string, error) { return m.ids(KindCase), nil }
+
+func (m *Memory) SaveTranscript(t core.Transcript) error {
+	if strings.TrimSpace(t.CaseID) == "" {
+		return fmt.Errorf("transcript.case_id is required")
+	}
+	t.SchemaVersion = core.SchemaVersion
+	return m.put(KindTranscript, t.CaseID, t)
+}
+
+func (m *Memory) LoadTranscript(caseID string) (core.Transcript, error) {
+	var t core.Transcript
+	m.mu.Lock()
+	_, hot := m.docs[KindTranscript][caseID]
+	data, archived := m.archived[caseID]
+	m.mu.Unlock()
+	if !hot && archived {
+		if err := core.Decode(core.DocTranscript, data, &t); err != nil {
+			return t, fmt.Errorf("decode transcript %s: %w", caseID, err)
+		}
+		return t, nil
+	}
+	return t, m.get(KindTranscript, caseID, &t)
+}
+
+func (m *Memory) TranscriptIDs() ([]string, error) {
+	archived, _ := m.ArchivedTranscriptIDs()
+	return mergeIDs(m.ids(KindTranscript), archived), nil
+}
+
+// ArchiveTranscripts moves transcripts aside; Memory keeps them
+// uncompressed and does not group them by month.
+func (m *Memory) ArchiveTranscripts(month string, caseIDs []string) error {
+	if !monthPattern.MatchString(month) {
+		return fmt.Errorf("archive month %q must be YYYY-MM", month)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, id := range caseIDs {
+		if _, ok := m.docs[KindTranscript][id]; !ok {
+			return fmt.Errorf("%s %s: %w", KindTranscript, id, fs.ErrNotExist)
+		}
+	}
+	for _, id := range caseIDs {
+		m.archived[id] = m.docs[KindTranscript][id]
+		delete(m.docs[KindTranscript], id)
+	}
+	return nil
+}
+
+func (m *Memory) ArchivedTranscriptIDs() ([]string, error) {
+	m.mu.Lock()
+	ids := make([]string, 0, len(m.archived))
+	for id := range m.archived {
+		if _, hot := m.docs[KindTranscript][id]; !hot {
+			ids = append(ids, id)
+		}
+	}
+	m.mu.Unlock()
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func (m *Memory) SaveVerdict(v core.Verdict) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	v.SchemaVersion = core.SchemaVersion
+	return m.create(KindVerdict, v.CaseID, v)
+}
+
+func (m *Memory) ReplaceVerdict(v core.Verdict) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	v.SchemaVersion = core.SchemaVersion
+	return m.put(KindVerdict, v.CaseID, v)
+}
+
+func (m *Memory) LoadVerdict(caseID string) (core.Verdict, error) {
+	var v core.Verdict
+	return v, m.get(KindVerdict, caseID, &v)
+}
+
+func (m *Memory) VerdictIDs() ([]string, error) { return m.ids(KindVerdict), nil }
+
+func (m *Memory) SavePending(p core.PendingDeliberation) error {
+	if strings.TrimSpace(p.CaseID) == "" {
+		return fmt.Errorf("pending.case_id is required")
+	}
+	p.SchemaVersion = core.SchemaVersion
+	return m.put(KindPending, p.CaseID, p)
+}
+
+func (m *Memory) LoadPending(caseID string) (core.PendingDeliberation, error) {
+	var p core.PendingDeliberation
+	return p, m.get(KindPending, caseID, &p)
+}
+
+func (m *Memory) DeletePending(caseID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.docs[KindPending], caseID)
+	return nil
+}
+
+func (m *Memory) PendingIDs() ([]string, error) { return m.ids(KindPending), nil }
+
+func (m *Memory) AppendVote(v core.Vote) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	v.SchemaVersion = core.SchemaVersion
+	return m.append(votesDir+"/"+v.CaseID, v)
+}
+
+func (m *Memory) LoadVotes(caseID string) ([]core.Vote, error) {
+	out := []core.Vote{}
+	for _, line := range m.list(votesDir + "/" + caseID) {
+		var v core.Vote
+		if err := core.Decode(core.DocVote, line, &v); err != nil {
+			return nil, fmt.Errorf("decode vote for %s: %w", caseID, err)
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func (m *Memory) WritePrompt(caseID, seat, round, body string) (string, error) {
+	loc := "memory:" + path.Join(promptsDir, caseID, seat+"-"+round+".md")
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.prompts[loc] = body
+	return loc, nil
+}
+
+// Prompt returns a prompt body by the location WritePrompt returned.
+func (m *Memory) Prompt(loc string) (string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	body, ok := m.prompts[loc]
+	return body, ok
+}
+
+func (m *Memory) AddPrecedent(r precedent.Record) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	r.SchemaVersion = core.SchemaVersion
+	return m.append(precedentsDir, r)
+}
+
+func (m *Memory) LoadPrecedents() ([]precedent.Record, error) {
+	out := []precedent.Record{}
+	for _, line := range m.list(precedentsDir) {
+		var r precedent.Record
+		if err := core.Decode(core.DocPrecedent, line, &r); err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func (m *Memory) SearchPrecedents(query string, opts precedent.SearchOptions) ([]precedent.Record, error) {
+	records, err := m.LoadPrecedents()
+	if err != nil {
+		return nil, err
+	}
+	return precedent.Search(records, query, opts), nil
+}
+
+func (m *Memory) AppendOutbox(topic string, envelope any) error {
+	return m.append(outboxDir+"/"+topic, envelope)
+}
+
+func (m *Memory) Outbox(topic string) ([]json.RawMessage, error) {
+	out := []json.RawMessage{}
+	for _, line := range m.list(outboxDir + "/" + topic) {
+		out = append(out, json.RawMessage(line))
+	}
+	return out, nil
+}
+
+func (m *Memory) OutboxTopics() ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	topics := []string{}
+	for key := range m.lines {
+		if topic, ok := strings.CutPrefix(key, outboxDir+"/"); ok {
+			topics = append(topics, topic)
+		}
+	}
+	sort.Strings(topics)
+	return topics, nil
+}
+
+func (m *Memory) AppendLedger(e ledger.Entry) error {
+	return m.append(ledgerDir, e)
+}
+
+func (m *Memory) LoadLedger() ([]ledger.Entry, error) {
+	out := []ledger.Entry{}
+	for _, line := range m.list(ledgerDir) {
+		var e ledger.Entry
+		if err := json.Unmarshal(line, &e); err != nil {
+			return nil, fmt.Errorf("decode ledger entry: %w", err)
+		}
+		out = append(out, e)
+	}
+	return out, nil
+}
+
+func (m *Memory) Update(fn func(Store) error) error { return fn(m) }
+
+func (m *Memory) View(fn func(Store) error) error { return fn(m) }
+
+func (m *Memory) Close() error { return nil }
+
+// StateRoot is empty: Memory keeps nothing on disk.
+func (m *Memory) StateRoot() string { return "" }
+
+func (m *Memory) Location(kind Kind, id string) string {
+	return "memory:" + string(kind) + "/" + id
+}
+
+func (m *Memory) put(kind Kind, id string, v any) error {
+	return m.store(kind, id, v, true)
+}
+
+func (m *Memory) create(kind Kind, id string, v any) error {
+	return m.store(kind, id, v, false)
+}
+
+func (m *Memory) store(kind Kind, id string, v any, replace bool) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.docs[kind][id]; ok && !replace {
+		return fmt.Errorf("%s %s: %w", kind, id, fs.ErrExist)
+	}
+	if m.docs[kind] == nil {
+		m.docs[kind] = map[string][]byte{}
+	}
+	m.docs[kind][id] = data
+	return nil
+}
+
+func (m *Memory) get(kind Kind, id string, v any) error {
+	m.mu.Lock()
+	data, ok := m.docs[kind][id]
+	m.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%s %s: %w", kind, id, fs.ErrNotExist)
+	}
+	if err := core.Decode(string(kind), data, v); err != nil {
+		return fmt.Errorf("decode %s %s: %w", kind, id, err)
+	}
+	return nil
+}
+
+func (m *Memory) ids(kind Kind) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ids := make([]string, 0, len(m.docs[kind]))
+	for id := range m.docs[kind] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (m *Memory) append(key string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lines[key] = append(m.lines[key], data)
+	return nil
+}
+
+func (m *Memory) list(key string) [][]byte {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([][]byte(nil), m.lines[key]...)
+}
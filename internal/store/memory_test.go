@@ -0,0 +1,166 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/ledger"
+	"github.com/Perttulands/senate/internal/precedent"
+)
+
+// testStore exercises the Store contract shared by every implementation.
+func testStore(t *testing.T, s Store) {
+	t.Helper()
+	now := time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC).Format(time.RFC3339)
+	c := core.Case{ID: "senate-7", Type: "general", Summary: "Summary", Question: "Question?", FiledAt: now}
+	if err := s.SaveCase(c); err != nil {
+		t.Fatalf("save case: %v", err)
+	}
+	if got, err := s.LoadCase("senate-7"); err != nil || got.Question != c.Question {
+		t.Fatalf("load case: %+v (%v)", got, err)
+	}
+	if _, err := s.LoadCase("missing"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected fs.ErrNotExist for a missing case, got %v", err)
+	}
+	if err := s.SaveCase(core.Case{ID: "bad"}); err == nil {
+		t.Fatal("expected invalid case to be rejected")
+	}
+	changed := c
+	changed.Question = "Changed?"
+	if err := s.SaveCase(changed); !errors.Is(err, fs.ErrExist) {
+		t.Fatalf("expected fs.ErrExist when overwriting a case, got %v", err)
+	}
+	if err := s.ReplaceCase(changed); err != nil {
+		t.Fatalf("replace case: %v", err)
+	}
+	if got, err := s.LoadCase("senate-7"); err != nil || got.Question != "Changed?" {
+		t.Fatalf("expected replaced case, got %+v (%v)", got, err)
+	}
+	if err := s.ReplaceCase(c); err != nil {
+		t.Fatalf("restore case: %v", err)
+	}
+	if ids, err := s.CaseIDs(); err != nil || len(ids) != 1 || ids[0] != "senate-7" {
+		t.Fatalf("case ids: %v (%v)", ids, err)
+	}
+
+	if err := s.SavePending(core.PendingDeliberation{CaseID: "senate-7", Round: core.RoundInitial}); err != nil {
+		t.Fatalf("save pending: %v", err)
+	}
+	if err := s.DeletePending("senate-7"); err != nil {
+		t.Fatalf("delete pending: %v", err)
+	}
+	if ids, _ := s.PendingIDs(); len(ids) != 0 {
+		t.Fatalf("expected no pending after delete, got %v", ids)
+	}
+
+	vote := core.Vote{CaseID: "senate-7", Seat: "alice", Round: core.RoundInitial, Stance: core.DecisionApprove, Reasoning: "ok", CastAt: now}
+	if err := s.AppendVote(vote); err != nil {
+		t.Fatalf("append vote: %v", err)
+	}
+	if votes, err := s.LoadVotes("senate-7"); err != nil || len(votes) != 1 || votes[0].Seat != "alice" {
+		t.Fatalf("load votes: %+v (%v)", votes, err)
+	}
+
+	rec := precedent.Record{CaseID: "senate-7", Type: "general", Summary: "Summary", Verdict: core.DecisionApprove, VerdictAt: now}
+	if err := s.AddPrecedent(rec); err != nil {
+		t.Fatalf("add precedent: %v", err)
+	}
+	if recs, err := s.LoadPrecedents(); err != nil || len(recs) != 1 || recs[0].CaseID != "senate-7" {
+		t.Fatalf("load precedents: %+v (%v)", recs, err)
+	}
+	if recs, err := s.SearchPrecedents("summary", precedent.SearchOptions{Type: "general"}); err != nil || len(recs) != 1 {
+		t.Fatalf("search precedents: %+v (%v)", recs, err)
+	}
+	err := s.View(func(tx Store) error {
+		recs, err := tx.SearchPrecedents("unrelated", precedent.SearchOptions{})
+		if err == nil && len(recs) != 0 {
+			t.Fatalf("expected no match, got %+v", recs)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("view: %v", err)
+	}
+
+	if entries, err := s.LoadLedger(); err != nil || len(entries) != 0 {
+		t.Fatalf("expected an empty ledger, got %+v (%v)", entries, err)
+	}
+	first, _ := ledger.Next(nil, ledger.KindPrecedent, "senate-7", rec, "issued", time.Now())
+	second, _ := ledger.Next(&first, ledger.KindPrecedent, "senate-7", rec, "issued", time.Now())
+	for _, e := range []ledger.Entry{first, second} {
+		if err := s.AppendLedger(e); err != nil {
+			t.Fatalf("append ledger: %v", err)
+		}
+	}
+	if entries, err := s.LoadLedger(); err != nil || len(entries) != 2 || entries[1].PrevHash != first.Hash {
+		t.Fatalf("load ledger: %+v (%v)", entries, err)
+	}
+
+	if err := s.SaveTranscript(core.Transcript{CaseID: "senate-7", CompletedAt: now}); err != nil {
+		t.Fatalf("save transcript: %v", err)
+	}
+	if err := s.ArchiveTranscripts("March", []string{"senate-7"}); err == nil {
+		t.Fatal("expected a malformed archive month to be rejected")
+	}
+	if err := s.ArchiveTranscripts("2026-03", []string{"missing"}); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected fs.ErrNotExist archiving a missing transcript, got %v", err)
+	}
+	if err := s.ArchiveTranscripts("2026-03", []string{"senate-7"}); err != nil {
+		t.Fatalf("archive transcripts: %v", err)
+	}
+	if ids, err := s.ArchivedTranscriptIDs(); err != nil || len(ids) != 1 || ids[0] != "senate-7" {
+		t.Fatalf("archived ids: %v (%v)", ids, err)
+	}
+	if ids, err := s.TranscriptIDs(); err != nil || len(ids) != 1 || ids[0] != "senate-7" {
+		t.Fatalf("expected archived transcripts listed, got %v (%v)", ids, err)
+	}
+	if tr, err := s.LoadTranscript("senate-7"); err != nil || tr.CompletedAt != now {
+		t.Fatalf("load archived transcript: %+v (%v)", tr, err)
+	}
+	if _, err := s.LoadTranscript("missing"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected fs.ErrNotExist for a missing transcript, got %v", err)
+	}
+
+	for _, id := range []string{"senate-7", "senate-8"} {
+		if err := s.AppendOutbox(OutboxVoteRequested, map[string]string{"case_id": id}); err != nil {
+			t.Fatalf("append outbox: %v", err)
+		}
+	}
+	queued, err := s.Outbox(OutboxVoteRequested)
+	if err != nil || len(queued) != 2 {
+		t.Fatalf("outbox: %d (%v)", len(queued), err)
+	}
+	var env map[string]string
+	if err := json.Unmarshal(queued[1], &env); err != nil || env["case_id"] != "senate-8" {
+		t.Fatalf("expected queue order kept, got %s (%v)", queued[1], err)
+	}
+	if empty, err := s.Outbox(OutboxCaseFiled); err != nil || len(empty) != 0 {
+		t.Fatalf("expected empty topic, got %d (%v)", len(empty), err)
+	}
+	if loc, err := s.WritePrompt("senate-7", "human-4", core.RoundInitial, "# Vote"); err != nil || loc == "" {
+		t.Fatalf("write prompt: %q (%v)", loc, err)
+	}
+}
+
+func TestDirImplementsStore(t *testing.T) {
+	d, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	testStore(t, d)
+}
+
+func TestMemoryImplementsStore(t *testing.T) {
+	m := NewMemory()
+	testStore(t, m)
+
+	c, _ := m.LoadCase("senate-7")
+	c.Question = "Changed?"
+	if again, _ := m.LoadCase("senate-7"); again.Question != "Question?" {
+		t.Fatal("expected loaded records not to alias stored state")
+	}
+}
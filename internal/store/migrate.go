@@ -0,0 +1,234 @@
+package store
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Perttulands/senate/internal/precedent"
+)
+
+// Counts tallies the records a migration copied.
+type Counts struct {
+	Cases       int `json:"cases"`
+	Transcripts int `json:"transcripts"`
+	Verdicts    int `json:"verdicts"`
+	Pending     int `json:"pending"`
+	Votes       int `json:"votes"`
+	Precedents  int `json:"precedents"`
+	Outbox      int `json:"outbox"`
+	Ledger      int `json:"ledger"`
+	// PrecedentsSkipped counts malformed precedent lines left behind.
+	PrecedentsSkipped int `json:"precedents_skipped,omitempty"`
+}
+
+// Copy writes every record in src to dst within one dst.Update, so a
+// transactional destination receives all of it or nothing. Prompts and
+// archived transcript bundles are files under the state root in every
+// backend and are not copied.
+func Copy(dst, src Store) (Counts, error) {
+	var n Counts
+	err := dst.Update(func(tx Store) error {
+		n = Counts{}
+		caseIDs, err := src.CaseIDs()
+		if err != nil {
+			return err
+		}
+		for _, id := range caseIDs {
+			c, err := src.LoadCase(id)
+			if err != nil {
+				return err
+			}
+			if err := tx.SaveCase(c); err != nil {
+				return fmt.Errorf("case %s: %w", id, err)
+			}
+			n.Cases++
+			votes, err := src.LoadVotes(id)
+			if err != nil {
+				return err
+			}
+			for _, v := range votes {
+				if err := tx.AppendVote(v); err != nil {
+					return fmt.Errorf("vote on %s: %w", id, err)
+				}
+				n.Votes++
+			}
+		}
+		ids, err := src.TranscriptIDs()
+		if err != nil {
+			return err
+		}
+		archived, err := src.ArchivedTranscriptIDs()
+		if err != nil {
+			return err
+		}
+		for _, id := range subtract(ids, archived) {
+			t, err := src.LoadTranscript(id)
+			if err != nil {
+				return err
+			}
+			if err := tx.SaveTranscript(t); err != nil {
+				return fmt.Errorf("transcript %s: %w", id, err)
+			}
+			n.Transcripts++
+		}
+		if ids, err = src.VerdictIDs(); err != nil {
+			return err
+		}
+		for _, id := range ids {
+			v, err := src.LoadVerdict(id)
+			if err != nil {
+				return err
+			}
+			if err := tx.SaveVerdict(v); err != nil {
+				return fmt.Errorf("verdict %s: %w", id, err)
+			}
+			n.Verdicts++
+		}
+		if ids, err = src.PendingIDs(); err != nil {
+			return err
+		}
+		for _, id := range ids {
+			p, err := src.LoadPending(id)
+			if err != nil {
+				return err
+			}
+			if err := tx.SavePending(p); err != nil {
+				return fmt.Errorf("pending %s: %w", id, err)
+			}
+			n.Pending++
+		}
+		records, err := src.LoadPrecedents()
+		if err != nil {
+			return err
+		}
+		for _, r := range records {
+			if err := tx.AddPrecedent(r); err != nil {
+				return fmt.Errorf("precedent %s: %w", r.CaseID, err)
+			}
+			n.Precedents++
+		}
+		topics, err := src.OutboxTopics()
+		if err != nil {
+			return err
+		}
+		for _, topic := range topics {
+			queued, err := src.Outbox(topic)
+			if err != nil {
+				return err
+			}
+			for _, env := range queued {
+				if err := tx.AppendOutbox(topic, env); err != nil {
+					return fmt.Errorf("outbox %s: %w", topic, err)
+				}
+				n.Outbox++
+			}
+		}
+		// Ledger entries are copied as they are: their hashes commit to the
+		// documents, not to where those are stored.
+		entries, err := src.LoadLedger()
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if err := tx.AppendLedger(e); err != nil {
+				return fmt.Errorf("ledger entry %d: %w", e.Seq, err)
+			}
+			n.Ledger++
+		}
+		return nil
+	})
+	return n, err
+}
+
+// recordDirs are the directories the layout keeps records in; prompts and
+// archived bundles stay where they are in either backend.
+var recordDirs = []string{casesDir, transcriptsDir, verdictsDir, pendingDir, votesDir, precedentsDir, outboxDir, ledgerDir}
+
+// MigrateToDB moves a directory-layout state root into the embedded
+// database. The state root lock is held from the copy until the record
+// directories are removed, once the database commits, so no write to them
+// is lost; prompts stay in place. Malformed precedent lines, which the
+// layout skips on read, are not copied and are counted.
+func MigrateToDB(root string) (Counts, error) {
+	if strings.TrimSpace(root) == "" {
+		root = "state"
+	}
+	var n Counts
+	src := &Dir{Root: root}
+	err := src.locked(func() error {
+		if _, err := os.Stat(filepath.Join(root, DBFile)); err == nil {
+			return fmt.Errorf("%s already uses the database backend", root)
+		}
+		held := &Dir{Root: root, held: true}
+		_, skipped, err := precedent.New(held.PrecedentIndexPath()).Scan()
+		if err != nil {
+			return err
+		}
+		dst, err := OpenDB(root)
+		if err != nil {
+			return err
+		}
+		if n, err = Copy(dst, held); err != nil {
+			_ = os.Remove(filepath.Join(root, DBFile))
+			return err
+		}
+		n.PrecedentsSkipped = skipped
+		for _, dir := range recordDirs {
+			if err := os.RemoveAll(filepath.Join(root, dir)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return Counts{}, err
+	}
+	return n, nil
+}
+
+// MigrateToDir moves an embedded database back to the directory layout and
+// removes the database file. The layout must not already hold records. The
+// state root lock and a read transaction on the database are held until
+// the file is removed; if the copy fails, the records it wrote are removed.
+func MigrateToDir(root string) (Counts, error) {
+	if strings.TrimSpace(root) == "" {
+		root = "state"
+	}
+	path := filepath.Join(root, DBFile)
+	var n Counts
+	dst := &Dir{Root: root}
+	err := dst.locked(func() error {
+		if _, err := os.Stat(path); err != nil {
+			return fmt.Errorf("%s does not use the database backend", root)
+		}
+		for _, dir := range recordDirs {
+			entries, err := os.ReadDir(filepath.Join(root, dir))
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			if len(entries) > 0 {
+				return fmt.Errorf("%s already holds directory-layout records", root)
+			}
+		}
+		src, err := OpenDB(root)
+		if err != nil {
+			return err
+		}
+		return src.View(func(tx Store) error {
+			n, err = Copy(&Dir{Root: root, held: true}, tx)
+			if err != nil {
+				for _, dir := range recordDirs {
+					_ = os.RemoveAll(filepath.Join(root, dir))
+				}
+				return err
+			}
+			return os.Remove(path)
+		})
+	})
+	if err != nil {
+		return Counts{}, err
+	}
+	return n, nil
+}
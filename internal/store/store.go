@@ -1,13 +1,148 @@
 package store
 
 import (
+	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"syscall"
 
 	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/ledger"
+	"github.com/Perttulands/senate/internal/precedent"
+)
+
+// Store persists Senate state: cases, transcripts, verdicts, paused
+// deliberations, votes, prompts, precedent and outbox queues, and the audit
+// ledger. Load methods return an error wrapping fs.ErrNotExist when nothing
+// is stored; listing methods return ids sorted ascending. Code that needs
+// only some records takes the narrower interface Store is made of.
+type Store interface {
+	CaseStore
+	TranscriptStore
+	VerdictStore
+	PendingStore
+	PrecedentStore
+	OutboxStore
+	LedgerStore
+
+	// Update runs fn against the store so that its writes commit together
+	// where the backend is transactional (DB); the directory layout applies
+	// them one by one under its lock and Memory one by one. An error from fn
+	// aborts the commit. fn must use the Store it is given, not the receiver.
+	Update(fn func(Store) error) error
+	// View runs fn against one consistent snapshot of the store. fn must
+	// only read: the DB rejects writes and the directory layout holds its
+	// lock shared.
+	View(fn func(Store) error) error
+	Close() error
+
+	// Location describes where a record of kind lives, for display.
+	Location(kind Kind, id string) string
+	// StateRoot is the directory files kept beside the records (prompts,
+	// signing keys) live under; "" for a store without one.
+	StateRoot() string
+}
+
+// CaseStore persists cases. SaveCase refuses to overwrite a stored case
+// with an error wrapping fs.ErrExist; ReplaceCase rewrites one on purpose,
+// for flows that amend a case or move it through its lifecycle.
+type CaseStore interface {
+	SaveCase(c core.Case) error
+	ReplaceCase(c core.Case) error
+	LoadCase(caseID string) (core.Case, error)
+	CaseIDs() ([]string, error)
+}
+
+// TranscriptStore persists transcripts, hot and archived.
+type TranscriptStore interface {
+	// LoadTranscript and TranscriptIDs cover archived transcripts as well;
+	// a hot copy wins over an archived one.
+	SaveTranscript(t core.Transcript) error
+	LoadTranscript(caseID string) (core.Transcript, error)
+	TranscriptIDs() ([]string, error)
+	// ArchiveTranscripts moves stored transcripts into a compressed bundle
+	// for month (YYYY-MM); ArchivedTranscriptIDs lists those read from one.
+	ArchiveTranscripts(month string, caseIDs []string) error
+	ArchivedTranscriptIDs() ([]string, error)
+}
+
+// VerdictStore persists verdicts. SaveVerdict refuses to overwrite a stored
+// verdict with an error wrapping fs.ErrExist; ReplaceVerdict reissues one.
+type VerdictStore interface {
+	SaveVerdict(v core.Verdict) error
+	ReplaceVerdict(v core.Verdict) error
+	LoadVerdict(caseID string) (core.Verdict, error)
+	VerdictIDs() ([]string, error)
+}
+
+// PendingStore persists paused deliberations with the human votes and
+// prompts they wait on.
+type PendingStore interface {
+	SavePending(p core.PendingDeliberation) error
+	LoadPending(caseID string) (core.PendingDeliberation, error)
+	DeletePending(caseID string) error
+	PendingIDs() ([]string, error)
+
+	AppendVote(v core.Vote) error
+	LoadVotes(caseID string) ([]core.Vote, error)
+
+	// WritePrompt stores a human seat prompt and returns its location.
+	WritePrompt(caseID, seat, round, body string) (string, error)
+}
+
+// PrecedentStore persists precedent records.
+type PrecedentStore interface {
+	AddPrecedent(r precedent.Record) error
+	LoadPrecedents() ([]precedent.Record, error)
+	// SearchPrecedents returns what precedent.Search finds among the
+	// records; the DB answers it from an index instead of loading them all.
+	SearchPrecedents(query string, opts precedent.SearchOptions) ([]precedent.Record, error)
+}
+
+// OutboxStore queues envelopes for other systems.
+type OutboxStore interface {
+	// AppendOutbox queues an envelope on an outbox topic; Outbox returns a
+	// topic's envelopes in the order they were queued.
+	AppendOutbox(topic string, envelope any) error
+	Outbox(topic string) ([]json.RawMessage, error)
+	OutboxTopics() ([]string, error)
+}
+
+// LedgerStore persists the audit ledger.
+type LedgerStore interface {
+	// AppendLedger adds an entry to the audit ledger; LoadLedger returns the
+	// entries in the order they were appended. The caller chains entries
+	// (ledger.Next) inside Update so no other writer slips in between.
+	AppendLedger(e ledger.Entry) error
+	LoadLedger() ([]ledger.Entry, error)
+}
+
+// Kind names a record type for Store.Location.
+type Kind string
+
+const (
+	KindCase       Kind = "case"
+	KindTranscript Kind = "transcript"
+	KindVerdict    Kind = "verdict"
+	KindPending    Kind = "pending"
+	KindOutbox     Kind = "outbox"
+	KindLedger     Kind = "ledger"
+	KindArchive    Kind = "archive"
+)
+
+// Outbox topics.
+const (
+	OutboxCaseFiled             = "case-filed"
+	OutboxVoteRequested         = "vote-requested"
+	OutboxEvidenceRequested     = "evidence-requested"
+	OutboxRatificationScheduled = "ratification-scheduled"
 )
 
 const (
@@ -16,13 +151,23 @@ const (
 	transcriptsDir = "transcripts"
 	precedentsDir  = "precedents"
 	outboxDir      = "outbox"
+	pendingDir     = "pending"
+	votesDir       = "votes"
+	promptsDir     = "prompts"
+	ledgerDir      = "ledger"
 )
 
-// Dir provides filesystem storage for Senate state.
+// Dir provides filesystem storage for Senate state. Writes hold an
+// advisory lock on the state root (LockFile) so concurrent senate processes
+// never interleave; JSONL reads take it shared.
 type Dir struct {
 	Root string
+	// held is set on the view Update passes to fn, which already holds the lock.
+	held bool
 }
 
+var _ Store = (*Dir)(nil)
+
 // New initializes the Senate state directory tree.
 func New(root string) (*Dir, error) {
 	if strings.TrimSpace(root) == "" {
@@ -34,6 +179,10 @@ func New(root string) (*Dir, error) {
 		filepath.Join(root, transcriptsDir),
 		filepath.Join(root, precedentsDir),
 		filepath.Join(root, outboxDir),
+		filepath.Join(root, pendingDir),
+		filepath.Join(root, votesDir),
+		filepath.Join(root, promptsDir),
+		filepath.Join(root, ledgerDir),
 	}
 	for _, p := range paths {
 		if err := os.MkdirAll(p, 0o755); err != nil {
@@ -43,6 +192,19 @@ func New(root string) (*Dir, error) {
 	return &Dir{Root: root}, nil
 }
 
+// Open returns the store for a state root without creating anything up
+// front: the DB when the root holds a database file, otherwise the
+// directory layout, whose writes create directories as needed.
+func Open(root string) (Store, error) {
+	if strings.TrimSpace(root) == "" {
+		root = "state"
+	}
+	if _, err := os.Stat(filepath.Join(root, DBFile)); err == nil {
+		return OpenDB(root)
+	}
+	return &Dir{Root: root}, nil
+}
+
 func (d *Dir) CasePath(caseID string) string {
 	return filepath.Join(d.Root, casesDir, caseID+".json")
 }
@@ -59,15 +221,64 @@ func (d *Dir) PrecedentIndexPath() string {
 	return filepath.Join(d.Root, precedentsDir, "index.jsonl")
 }
 
-func (d *Dir) RelayOutboxPath() string {
-	return filepath.Join(d.Root, outboxDir, "case-filed.jsonl")
+// OutboxPath is the JSONL queue for an outbox topic.
+func (d *Dir) OutboxPath(topic string) string {
+	return filepath.Join(d.Root, outboxDir, topic+".jsonl")
+}
+
+func (d *Dir) PendingPath(caseID string) string {
+	return filepath.Join(d.Root, pendingDir, caseID+".json")
+}
+
+func (d *Dir) VotesPath(caseID string) string {
+	return filepath.Join(d.Root, votesDir, caseID+".jsonl")
+}
+
+// PromptPath is the prompt file handed to a human seat for one round.
+func (d *Dir) PromptPath(caseID, seat, round string) string {
+	return filepath.Join(d.Root, promptsDir, caseID, seat+"-"+round+".md")
+}
+
+// LedgerPath is the append-only audit ledger.
+func (d *Dir) LedgerPath() string {
+	return filepath.Join(d.Root, ledgerDir, "chain.jsonl")
+}
+
+// Location returns the file backing a record.
+func (d *Dir) Location(kind Kind, id string) string {
+	switch kind {
+	case KindCase:
+		return d.CasePath(id)
+	case KindTranscript:
+		return d.TranscriptPath(id)
+	case KindVerdict:
+		return d.VerdictPath(id)
+	case KindPending:
+		return d.PendingPath(id)
+	case KindOutbox:
+		return d.OutboxPath(id)
+	case KindLedger:
+		return d.LedgerPath()
+	case KindArchive:
+		return d.ArchivePath(id)
+	}
+	return d.Root
 }
 
 func (d *Dir) SaveCase(c core.Case) error {
 	if err := c.Validate(); err != nil {
 		return err
 	}
-	return atomicWriteJSON(d.CasePath(c.ID), c)
+	c.SchemaVersion = core.SchemaVersion
+	return d.locked(func() error { return createJSON(KindCase, c.ID, d.CasePath(c.ID), c) })
+}
+
+func (d *Dir) ReplaceCase(c core.Case) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	c.SchemaVersion = core.SchemaVersion
+	return d.locked(func() error { return atomicWriteJSON(d.CasePath(c.ID), c) })
 }
 
 func (d *Dir) LoadCase(caseID string) (core.Case, error) {
@@ -76,7 +287,7 @@ func (d *Dir) LoadCase(caseID string) (core.Case, error) {
 	if err != nil {
 		return c, err
 	}
-	if err := json.Unmarshal(data, &c); err != nil {
+	if err := core.Decode(core.DocCase, data, &c); err != nil {
 		return c, fmt.Errorf("decode case %s: %w", caseID, err)
 	}
 	return c, nil
@@ -86,14 +297,65 @@ func (d *Dir) SaveTranscript(t core.Transcript) error {
 	if strings.TrimSpace(t.CaseID) == "" {
 		return fmt.Errorf("transcript.case_id is required")
 	}
-	return atomicWriteJSON(d.TranscriptPath(t.CaseID), t)
+	t.SchemaVersion = core.SchemaVersion
+	return d.locked(func() error { return atomicWriteJSON(d.TranscriptPath(t.CaseID), t) })
+}
+
+func (d *Dir) LoadTranscript(caseID string) (core.Transcript, error) {
+	var t core.Transcript
+	data, err := os.ReadFile(d.TranscriptPath(caseID))
+	if os.IsNotExist(err) {
+		if archived, ok, aerr := (transcriptArchive{d.Root}).load(caseID); ok || aerr != nil {
+			return archived, aerr
+		}
+	}
+	if err != nil {
+		return t, err
+	}
+	if err := core.Decode(core.DocTranscript, data, &t); err != nil {
+		return t, fmt.Errorf("decode transcript %s: %w", caseID, err)
+	}
+	return t, nil
+}
+
+// CaseIDs lists stored case IDs, sorted ascending.
+func (d *Dir) CaseIDs() ([]string, error) {
+	return listIDs(filepath.Join(d.Root, casesDir))
+}
+
+// TranscriptIDs lists case IDs with a stored transcript, hot or archived,
+// sorted ascending.
+func (d *Dir) TranscriptIDs() ([]string, error) {
+	hot, err := listIDs(filepath.Join(d.Root, transcriptsDir))
+	if err != nil {
+		return nil, err
+	}
+	archived, err := transcriptArchive{d.Root}.ids()
+	if err != nil {
+		return nil, err
+	}
+	return mergeIDs(hot, archived), nil
+}
+
+// VerdictIDs lists case IDs with a stored verdict, sorted ascending.
+func (d *Dir) VerdictIDs() ([]string, error) {
+	return listIDs(filepath.Join(d.Root, verdictsDir))
 }
 
 func (d *Dir) SaveVerdict(v core.Verdict) error {
 	if err := v.Validate(); err != nil {
 		return err
 	}
-	return atomicWriteJSON(d.VerdictPath(v.CaseID), v)
+	v.SchemaVersion = core.SchemaVersion
+	return d.locked(func() error { return createJSON(KindVerdict, v.CaseID, d.VerdictPath(v.CaseID), v) })
+}
+
+func (d *Dir) ReplaceVerdict(v core.Verdict) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	v.SchemaVersion = core.SchemaVersion
+	return d.locked(func() error { return atomicWriteJSON(d.VerdictPath(v.CaseID), v) })
 }
 
 func (d *Dir) LoadVerdict(caseID string) (core.Verdict, error) {
@@ -102,12 +364,284 @@ func (d *Dir) LoadVerdict(caseID string) (core.Verdict, error) {
 	if err != nil {
 		return v, err
 	}
-	if err := json.Unmarshal(data, &v); err != nil {
+	if err := core.Decode(core.DocVerdict, data, &v); err != nil {
 		return v, fmt.Errorf("decode verdict %s: %w", caseID, err)
 	}
 	return v, nil
 }
 
+func (d *Dir) SavePending(p core.PendingDeliberation) error {
+	if strings.TrimSpace(p.CaseID) == "" {
+		return fmt.Errorf("pending.case_id is required")
+	}
+	p.SchemaVersion = core.SchemaVersion
+	return d.locked(func() error { return atomicWriteJSON(d.PendingPath(p.CaseID), p) })
+}
+
+func (d *Dir) LoadPending(caseID string) (core.PendingDeliberation, error) {
+	var p core.PendingDeliberation
+	data, err := os.ReadFile(d.PendingPath(caseID))
+	if err != nil {
+		return p, err
+	}
+	if err := core.Decode(core.DocPending, data, &p); err != nil {
+		return p, fmt.Errorf("decode pending %s: %w", caseID, err)
+	}
+	return p, nil
+}
+
+// DeletePending removes a paused deliberation once it completes.
+func (d *Dir) DeletePending(caseID string) error {
+	return d.locked(func() error {
+		err := os.Remove(d.PendingPath(caseID))
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return syncDir(filepath.Dir(d.PendingPath(caseID)))
+	})
+}
+
+// PendingIDs lists case IDs of paused deliberations, sorted ascending.
+func (d *Dir) PendingIDs() ([]string, error) {
+	return listIDs(filepath.Join(d.Root, pendingDir))
+}
+
+func (d *Dir) AppendVote(v core.Vote) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	v.SchemaVersion = core.SchemaVersion
+	return d.locked(func() error { return AppendJSONL(d.VotesPath(v.CaseID), v) })
+}
+
+// LoadVotes returns every vote cast on a case in submission order.
+func (d *Dir) LoadVotes(caseID string) (votes []core.Vote, err error) {
+	err = d.shared(func() error {
+		votes, err = d.readVotes(caseID)
+		return err
+	})
+	return votes, err
+}
+
+// maxLineSize bounds one JSONL record (vote, outbox envelope, ledger entry);
+// bufio.Scanner's 64KB default would reject a long vote reasoning.
+const maxLineSize = 64 << 20
+
+// newLineScanner scans the JSONL records of r up to maxLineSize each.
+func newLineScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	return scanner
+}
+
+func (d *Dir) readVotes(caseID string) ([]core.Vote, error) {
+	f, err := os.Open(d.VotesPath(caseID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []core.Vote{}, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	var out []core.Vote
+	scanner := newLineScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var v core.Vote
+		if err := core.Decode(core.DocVote, []byte(line), &v); err != nil {
+			return nil, fmt.Errorf("decode vote for %s: %w", caseID, err)
+		}
+		out = append(out, v)
+	}
+	return out, scanner.Err()
+}
+
+// WritePrompt stores a human seat prompt, creating the case prompt dir.
+func (d *Dir) WritePrompt(caseID, seat, round, body string) (string, error) {
+	path := d.PromptPath(caseID, seat, round)
+	return path, d.locked(func() error { return atomicWrite(path, []byte(body)) })
+}
+
+func (d *Dir) AddPrecedent(r precedent.Record) error {
+	return d.locked(func() error { return precedent.New(d.PrecedentIndexPath()).Add(r) })
+}
+
+func (d *Dir) LoadPrecedents() (records []precedent.Record, err error) {
+	err = d.shared(func() error {
+		records, err = precedent.New(d.PrecedentIndexPath()).LoadAll()
+		return err
+	})
+	return records, err
+}
+
+// SearchPrecedents scans the whole precedent index; the file has no other.
+func (d *Dir) SearchPrecedents(query string, opts precedent.SearchOptions) ([]precedent.Record, error) {
+	records, err := d.LoadPrecedents()
+	if err != nil {
+		return nil, err
+	}
+	return precedent.Search(records, query, opts), nil
+}
+
+func (d *Dir) AppendOutbox(topic string, envelope any) error {
+	return d.locked(func() error { return AppendJSONL(d.OutboxPath(topic), envelope) })
+}
+
+// Outbox reads a topic's queued envelopes; a missing queue is empty.
+func (d *Dir) Outbox(topic string) (queued []json.RawMessage, err error) {
+	err = d.shared(func() error {
+		queued, err = d.readOutbox(topic)
+		return err
+	})
+	return queued, err
+}
+
+func (d *Dir) readOutbox(topic string) ([]json.RawMessage, error) {
+	f, err := os.Open(d.OutboxPath(topic))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []json.RawMessage{}, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	var out []json.RawMessage
+	scanner := newLineScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		out = append(out, json.RawMessage(line))
+	}
+	return out, scanner.Err()
+}
+
+// OutboxTopics lists topics with a queue file, sorted ascending.
+func (d *Dir) OutboxTopics() ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(d.Root, outboxDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	topics := []string{}
+	for _, e := range entries {
+		if name := e.Name(); !e.IsDir() && strings.HasSuffix(name, ".jsonl") {
+			topics = append(topics, strings.TrimSuffix(name, ".jsonl"))
+		}
+	}
+	sort.Strings(topics)
+	return topics, nil
+}
+
+func (d *Dir) AppendLedger(e ledger.Entry) error {
+	return d.locked(func() error { return AppendJSONL(d.LedgerPath(), e) })
+}
+
+// LoadLedger reads the audit ledger; a missing ledger is empty.
+func (d *Dir) LoadLedger() (entries []ledger.Entry, err error) {
+	err = d.shared(func() error {
+		entries, err = d.readLedger()
+		return err
+	})
+	return entries, err
+}
+
+func (d *Dir) readLedger() ([]ledger.Entry, error) {
+	f, err := os.Open(d.LedgerPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []ledger.Entry{}, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	out := []ledger.Entry{}
+	scanner := newLineScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var e ledger.Entry
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			return nil, fmt.Errorf("decode ledger entry: %w", err)
+		}
+		out = append(out, e)
+	}
+	return out, scanner.Err()
+}
+
+// Update holds the state root lock across fn, so other processes see its
+// writes together; they are not rolled back if fn fails.
+func (d *Dir) Update(fn func(Store) error) error {
+	if d.held {
+		return fn(d)
+	}
+	return d.locked(func() error { return fn(&Dir{Root: d.Root, held: true}) })
+}
+
+// View holds the state root lock shared across fn.
+func (d *Dir) View(fn func(Store) error) error {
+	if d.held {
+		return fn(d)
+	}
+	return d.shared(func() error { return fn(&Dir{Root: d.Root, held: true}) })
+}
+
+func (d *Dir) Close() error { return nil }
+
+func (d *Dir) StateRoot() string { return d.Root }
+
+// AppendJSONL appends one JSON document as a line, creating parent dirs.
+func AppendJSONL(path string, value any) error {
+	line, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.Write(line); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+func listIDs(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	ids := make([]string, 0, len(entries))
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		ids = append(ids, strings.TrimSuffix(name, ".json"))
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
 func atomicWriteJSON(path string, v any) error {
 	out, err := json.MarshalIndent(v, "", "  ")
 	if err != nil {
@@ -117,10 +651,61 @@ func atomicWriteJSON(path string, v any) error {
 	return atomicWrite(path, out)
 }
 
+// createJSON writes v to path unless a record is already stored there. The
+// caller holds the state root lock, so the check and the write are one step.
+func createJSON(kind Kind, id, path string, v any) error {
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("%s %s: %w", kind, id, fs.ErrExist)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	return atomicWriteJSON(path, v)
+}
+
+// atomicWrite replaces path with body through a uniquely named temp file in
+// the same directory. The file is synced before the rename and the
+// directory after it, so a crash leaves either the old or the new content.
 func atomicWrite(path string, body []byte) error {
-	tmp := path + ".tmp"
-	if err := os.WriteFile(tmp, body, 0o644); err != nil {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+	if _, err := f.Write(body); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(0o644); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
+
+// syncDir flushes a directory entry change (rename, create, remove) to disk.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	if err := d.Sync(); err != nil && !errors.Is(err, syscall.EINVAL) {
 		return err
 	}
-	return os.Rename(tmp, path)
+	return nil
 }
@@ -1,7 +1,13 @@
 package store
 
 import (
+	"fmt"
+	"os"
+	"os/exec"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -45,7 +51,139 @@ func TestPaths(t *testing.T) {
 	if got := d.PrecedentIndexPath(); filepath.Base(got) != "index.jsonl" {
 		t.Fatalf("expected precedent index filename, got %q", got)
 	}
-	if got := d.RelayOutboxPath(); filepath.Base(got) != "case-filed.jsonl" {
+	if got := d.OutboxPath(OutboxCaseFiled); filepath.Base(got) != "case-filed.jsonl" {
 		t.Fatalf("expected relay outbox filename, got %q", got)
 	}
 }
+
+// writerEnv makes a re-executed test binary act as one writer process of
+// TestConcurrentProcessesWriteSameRecord.
+const writerEnv = "SENATE_STORE_WRITER"
+
+func TestConcurrentProcessesWriteSameRecord(t *testing.T) {
+	const writes = 20
+	if spec := os.Getenv(writerEnv); spec != "" {
+		var root string
+		var proc int
+		if _, err := fmt.Sscanf(spec, "%d %s", &proc, &root); err != nil {
+			t.Fatal(err)
+		}
+		d := &Dir{Root: root}
+		for i := 0; i < writes; i++ {
+			tag := fmt.Sprintf("p%d-%d", proc, i)
+			err := d.Update(func(tx Store) error {
+				c, err := tx.LoadCase("senate-shared")
+				if err != nil {
+					return err
+				}
+				c.Evidence = append(c.Evidence, tag)
+				return tx.ReplaceCase(c)
+			})
+			if err != nil {
+				t.Fatalf("replace case: %v", err)
+			}
+			v := core.Vote{CaseID: "senate-shared", Seat: "human-" + strconv.Itoa(proc), Round: core.RoundInitial, Stance: core.DecisionApprove, Reasoning: tag}
+			if err := d.AppendVote(v); err != nil {
+				t.Fatalf("append vote: %v", err)
+			}
+		}
+		return
+	}
+
+	root := t.TempDir()
+	d, err := New(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SaveCase(core.Case{ID: "senate-shared", Type: "general", Summary: "Shared", Question: "Who writes last?", FiledAt: time.Now().UTC().Format(time.RFC3339)}); err != nil {
+		t.Fatal(err)
+	}
+	const procs = 4
+	var wg sync.WaitGroup
+	errs := make([]error, procs)
+	outs := make([][]byte, procs)
+	for p := 0; p < procs; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			cmd := exec.Command(os.Args[0], "-test.run=^TestConcurrentProcessesWriteSameRecord$")
+			cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%d %s", writerEnv, p, root))
+			outs[p], errs[p] = cmd.CombinedOutput()
+		}(p)
+	}
+	wg.Wait()
+	for p, err := range errs {
+		if err != nil {
+			t.Fatalf("writer %d: %v\n%s", p, err, outs[p])
+		}
+	}
+
+	c, err := d.LoadCase("senate-shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Evidence) != procs*writes {
+		t.Fatalf("expected %d case updates, got %d: updates were lost", procs*writes, len(c.Evidence))
+	}
+	votes, err := d.LoadVotes("senate-shared")
+	if err != nil || len(votes) != procs*writes {
+		t.Fatalf("expected %d intact votes, got %d (%v)", procs*writes, len(votes), err)
+	}
+}
+
+func TestArchiveAddsBundlePartsWithoutRewriting(t *testing.T) {
+	d, err := New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"senate-a", "senate-b"} {
+		if err := d.SaveTranscript(core.Transcript{CaseID: id, CompletedAt: "2025-01-15T10:00:00Z"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := d.ArchiveTranscripts("2025-01", []string{"senate-a"}); err != nil {
+		t.Fatal(err)
+	}
+	first := d.ArchivePath("2025-01")
+	before, err := os.ReadFile(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.ArchiveTranscripts("2025-01", []string{"senate-b"}); err != nil {
+		t.Fatal(err)
+	}
+	after, err := os.ReadFile(first)
+	if err != nil || string(after) != string(before) {
+		t.Fatalf("expected the first bundle left untouched (%v)", err)
+	}
+	if second := d.ArchivePath("2025-01"); second == first || filepath.Base(second) != "transcripts-2025-01.2.jsonl.gz" {
+		t.Fatalf("expected a second part, got %s", second)
+	}
+	for _, id := range []string{"senate-a", "senate-b"} {
+		if tr, err := d.LoadTranscript(id); err != nil || tr.CaseID != id {
+			t.Fatalf("expected archived %s readable, got %+v (%v)", id, tr, err)
+		}
+	}
+}
+
+func TestReadsRecordsLongerThanScannerDefault(t *testing.T) {
+	d, err := New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	long := strings.Repeat("reasoning ", 20000)
+	v := core.Vote{CaseID: "senate-1", Seat: "human-1", Round: core.RoundInitial, Stance: core.DecisionApprove, Reasoning: long, CastAt: "2026-01-01T00:00:00Z"}
+	if err := d.AppendVote(v); err != nil {
+		t.Fatal(err)
+	}
+	votes, err := d.LoadVotes("senate-1")
+	if err != nil || len(votes) != 1 || votes[0].Reasoning != long {
+		t.Fatalf("expected the long vote back, got %d votes (%v)", len(votes), err)
+	}
+	if err := d.AppendOutbox(OutboxCaseFiled, map[string]string{"summary": long}); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := d.Outbox(OutboxCaseFiled); err != nil || len(out) != 1 {
+		t.Fatalf("expected the long envelope back, got %d (%v)", len(out), err)
+	}
+}
@@ -0,0 +1,230 @@
+// Package tools provides the read-only tools offered to seats during deliberation.
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/deliberation"
+	"github.com/Perttulands/senate/internal/precedent"
+	"github.com/Perttulands/senate/internal/store"
+)
+
+// maxEvidenceBytes caps how much of an evidence file a seat can read.
+const maxEvidenceBytes = 8 << 10
+
+// Tool names.
+const (
+	SearchPrecedents = "search_precedents"
+	ReadEvidence     = "read_evidence"
+	ListVerdicts     = "list_verdicts"
+)
+
+// Records is the part of the state store the tools read.
+type Records interface {
+	store.PrecedentStore
+	store.VerdictStore
+}
+
+// Defaults returns every tool, backed by the state store and reading
+// evidence files from under evidenceRoot.
+func Defaults(s Records, evidenceRoot string) []deliberation.Tool {
+	return []deliberation.Tool{
+		PrecedentSearch{Store: s},
+		EvidenceReader{Root: evidenceRoot},
+		VerdictList{Store: s},
+	}
+}
+
+// Select picks tools by name from available; "all" selects every tool.
+func Select(names []string, available []deliberation.Tool) ([]deliberation.Tool, error) {
+	byName := make(map[string]deliberation.Tool, len(available))
+	known := make([]string, 0, len(available))
+	for _, t := range available {
+		byName[t.Name()] = t
+		known = append(known, t.Name())
+	}
+	var out []deliberation.Tool
+	for _, name := range names {
+		if name == "all" {
+			return available, nil
+		}
+		t, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown tool %q (want %s or all)", name, strings.Join(known, ", "))
+		}
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+// PrecedentSearch searches stored precedent, excluding the case itself.
+// Args: query (defaults to the case summary), type, limit (default 3, max 10).
+type PrecedentSearch struct {
+	Store store.PrecedentStore
+}
+
+func (PrecedentSearch) Name() string { return SearchPrecedents }
+
+func (PrecedentSearch) Description() string {
+	return "Search past verdicts by keywords; args: query, type, limit"
+}
+
+func (t PrecedentSearch) Call(c core.Case, args map[string]string) (string, error) {
+	query := strings.TrimSpace(args["query"])
+	if query == "" {
+		query = c.Summary
+	}
+	limit := boundedLimit(args["limit"], 3, 10)
+	records, err := t.Store.SearchPrecedents(query, precedent.SearchOptions{Type: strings.TrimSpace(args["type"]), Limit: limit + 1})
+	if err != nil {
+		return "", err
+	}
+	var lines []string
+	for _, r := range records {
+		if r.CaseID == c.ID || strings.HasPrefix(r.CaseID, c.ID+"#") {
+			continue
+		}
+		decision := string(r.Verdict)
+		if r.Outcome != "" {
+			decision += " " + r.Outcome
+		}
+		lines = append(lines, fmt.Sprintf("%s (%s): %s", r.CaseID, decision, r.Summary))
+		if len(lines) == limit {
+			break
+		}
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// EvidenceReader reads one evidence item listed on the case. Items naming a
+// file under Root return its contents (truncated); other items return their
+// text. Paths escaping Root, including through symlinks, are refused.
+type EvidenceReader struct {
+	Root string
+}
+
+func (EvidenceReader) Name() string { return ReadEvidence }
+
+func (EvidenceReader) Description() string {
+	return "Read one of the case's evidence items; args: ref"
+}
+
+func (t EvidenceReader) Call(c core.Case, args map[string]string) (string, error) {
+	ref := strings.TrimSpace(args["ref"])
+	listed := false
+	for _, e := range c.Evidence {
+		if strings.TrimSpace(e) == ref {
+			listed = true
+			break
+		}
+	}
+	if ref == "" || !listed {
+		return "", fmt.Errorf("evidence %q is not listed on case %s", ref, c.ID)
+	}
+	root := t.Root
+	if root == "" {
+		root = "."
+	}
+	if filepath.IsAbs(ref) {
+		return "", fmt.Errorf("evidence %q: absolute paths are not readable", ref)
+	}
+	name := filepath.Clean(ref)
+	path := filepath.Join(root, name)
+	if rel, err := filepath.Rel(root, path); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("evidence %q escapes the evidence root", ref)
+	}
+	// Reads go through an os.Root so symlinks cannot lead out of it.
+	dir, err := os.OpenRoot(root)
+	if err != nil {
+		return ref, nil
+	}
+	defer dir.Close()
+	info, err := dir.Stat(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return ref, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("evidence %q: %w", ref, err)
+	}
+	if !info.Mode().IsRegular() {
+		return ref, nil
+	}
+	f, err := dir.Open(name)
+	if err != nil {
+		return "", fmt.Errorf("evidence %q: %w", ref, err)
+	}
+	defer f.Close()
+	data, err := io.ReadAll(io.LimitReader(f, maxEvidenceBytes+1))
+	if err != nil {
+		return "", err
+	}
+	if len(data) > maxEvidenceBytes {
+		return string(data[:maxEvidenceBytes]) + "\n[truncated]", nil
+	}
+	return string(data), nil
+}
+
+// VerdictList lists recent stored verdicts of a case type, newest first,
+// excluding the case itself. Args: type (defaults to the case type), limit
+// (default 5, max 20).
+type VerdictList struct {
+	Store store.VerdictStore
+}
+
+func (VerdictList) Name() string { return ListVerdicts }
+
+func (VerdictList) Description() string {
+	return "List prior verdicts of a case type; args: type, limit"
+}
+
+func (t VerdictList) Call(c core.Case, args map[string]string) (string, error) {
+	caseType := strings.TrimSpace(args["type"])
+	if caseType == "" {
+		caseType = c.Type
+	}
+	ids, err := t.Store.VerdictIDs()
+	if err != nil {
+		return "", err
+	}
+	var verdicts []core.Verdict
+	for _, id := range ids {
+		if id == c.ID {
+			continue
+		}
+		v, err := t.Store.LoadVerdict(id)
+		if err != nil || v.Type != caseType {
+			continue
+		}
+		verdicts = append(verdicts, v)
+	}
+	sort.SliceStable(verdicts, func(i, j int) bool { return verdicts[i].VerdictAt > verdicts[j].VerdictAt })
+	limit := boundedLimit(args["limit"], 5, 20)
+	if len(verdicts) > limit {
+		verdicts = verdicts[:limit]
+	}
+	lines := make([]string, 0, len(verdicts))
+	for _, v := range verdicts {
+		lines = append(lines, fmt.Sprintf("%s %s %s: %s", v.CaseID, v.VerdictAt, v.Verdict, v.Summary))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func boundedLimit(raw string, def, max int) int {
+	n, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || n <= 0 {
+		return def
+	}
+	if n > max {
+		return max
+	}
+	return n
+}
@@ -0,0 +1,103 @@
+package tools
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Perttulands/senate/internal/core"
+	"github.com/Perttulands/senate/internal/precedent"
+	"github.com/Perttulands/senate/internal/store"
+)
+
+func TestEvidenceReaderScopesToCase(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "reports"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "reports", "fp-47.md"), []byte("47 false positives"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c := core.Case{ID: "senate-30", Evidence: []string{"reports/fp-47.md", "team survey", "../secret.txt"}}
+	r := EvidenceReader{Root: root}
+
+	if got, err := r.Call(c, map[string]string{"ref": "reports/fp-47.md"}); err != nil || got != "47 false positives" {
+		t.Fatalf("expected file contents, got %q (%v)", got, err)
+	}
+	if got, err := r.Call(c, map[string]string{"ref": "team survey"}); err != nil || got != "team survey" {
+		t.Fatalf("expected text evidence echoed, got %q (%v)", got, err)
+	}
+	if _, err := r.Call(c, map[string]string{"ref": "../secret.txt"}); err == nil {
+		t.Fatal("expected escaping path refused")
+	}
+	if _, err := r.Call(c, map[string]string{"ref": "reports/other.md"}); err == nil {
+		t.Fatal("expected unlisted evidence refused")
+	}
+}
+
+func TestEvidenceReaderRefusesSymlinkOutOfRoot(t *testing.T) {
+	root := t.TempDir()
+	secret := filepath.Join(t.TempDir(), "signing.key")
+	if err := os.WriteFile(secret, []byte("private"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(secret, filepath.Join(root, "report.md")); err != nil {
+		t.Skipf("symlinks unavailable: %v", err)
+	}
+	if err := os.Symlink(filepath.Dir(secret), filepath.Join(root, "reports")); err != nil {
+		t.Fatal(err)
+	}
+	c := core.Case{ID: "senate-31", Evidence: []string{"report.md", "reports/signing.key"}}
+	r := EvidenceReader{Root: root}
+	for _, ref := range c.Evidence {
+		if got, err := r.Call(c, map[string]string{"ref": ref}); err == nil || strings.Contains(got, "private") {
+			t.Fatalf("expected %s refused, got %q (%v)", ref, got, err)
+		}
+	}
+}
+
+func TestPrecedentSearchAndVerdictListExcludeCase(t *testing.T) {
+	d := store.NewMemory()
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	for i, id := range []string{"senate-1", "senate-2"} {
+		v := core.Verdict{
+			CaseID:         id,
+			FiledAt:        now.Format(time.RFC3339),
+			VerdictAt:      now.Add(time.Duration(i) * time.Hour).Format(time.RFC3339),
+			Type:           "general",
+			Summary:        "Cache rollout plan",
+			Verdict:        core.DecisionApprove,
+			Reasoning:      "Staged rollout.",
+			Implementation: "Roll out.",
+			Binding:        true,
+			Judge:          "claude:opus",
+		}
+		if err := d.SaveVerdict(v); err != nil {
+			t.Fatal(err)
+		}
+		if err := d.AddPrecedent(precedent.FromVerdict(v)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c := core.Case{ID: "senate-2", Type: "general", Summary: "Cache rollout plan"}
+	available := Defaults(d, "")
+
+	search, err := Select([]string{SearchPrecedents}, available)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := search[0].Call(c, nil)
+	if err != nil || !strings.HasPrefix(got, "senate-1 (approved)") || strings.Contains(got, "senate-2") {
+		t.Fatalf("expected only senate-1, got %q (%v)", got, err)
+	}
+	list, _ := Select([]string{ListVerdicts}, available)
+	got, err = list[0].Call(c, map[string]string{"limit": "5"})
+	if err != nil || strings.Count(got, "\n") != 0 || !strings.HasPrefix(got, "senate-1 ") {
+		t.Fatalf("expected one listed verdict, got %q (%v)", got, err)
+	}
+	if _, err := Select([]string{"shell"}, available); err == nil {
+		t.Fatal("expected unknown tool error")
+	}
+}